@@ -9,7 +9,7 @@ func NotNil[T any](value T) error {
 	switch vof.Kind() {
 	case reflect.Chan, reflect.Func, reflect.Map, reflect.Ptr, reflect.Interface, reflect.Slice:
 		if vof.IsNil() {
-			return &Violation{Code: CodeNotNil}
+			return &Violation{Code: CodeNotNil, Message: "must not be nil"}
 		}
 	}
 
@@ -20,7 +20,7 @@ func NotNil[T any](value T) error {
 func Not[T comparable](not T) Validator[T] {
 	return func(value T) error {
 		if value == not {
-			return &Violation{Code: CodeNot, Args: Args{"not": not}}
+			return &Violation{Code: CodeNot, Args: Args{"not": not}, Message: "must not be the disallowed value"}
 		}
 
 		return nil
@@ -32,7 +32,7 @@ func Required[T comparable](value T) error {
 	var x T // Create the nullable value for the type
 
 	if value == x {
-		return &Violation{Code: CodeRequired}
+		return &Violation{Code: CodeRequired, Message: "is required"}
 	}
 
 	return nil
@@ -43,7 +43,7 @@ func Required[T comparable](value T) error {
 func Equal[T comparable](expected T) Validator[T] {
 	return func(value T) error {
 		if value != expected {
-			return &Violation{Code: CodeEqual, Args: Args{"expected": expected}}
+			return &Violation{Code: CodeEqual, Args: Args{"expected": expected}, Message: "must be equal to the expected value"}
 		}
 
 		return nil
@@ -59,6 +59,6 @@ func OneOf[T comparable](accepted ...T) Validator[T] {
 			}
 		}
 
-		return &Violation{Code: CodeOneOf, Args: Args{"accepted": accepted}}
+		return &Violation{Code: CodeOneOf, Args: Args{"accepted": accepted}, Message: "must be one of the accepted values"}
 	}
 }
@@ -1,22 +1,39 @@
 package validate
 
 const (
-	CodeUnknownField = "unknown.field"
-	CodeNotFound     = "not.found"
-	CodeRequired     = "required"
-	CodeNotNil       = "not.nil"
-	CodeNot          = "not"
-	CodeEqual        = "equal"
-	CodeOneOf        = "oneof"
-	CodeNumberMin    = "min.number"
-	CodeNumberMax    = "max.number"
-	CodeStringMin    = "min.string"
-	CodeStringMax    = "max.string"
-	CodePrefix       = "prefix"
-	CodeSuffix       = "suffix"
-	CodeEmail        = "email"
-	CodeRegex        = "regex"
-	CodeLowercase    = "lowercase"
-	CodeUppercase    = "uppercase"
-	CodeIBAN         = "iban"
+	CodeUnknownField       = "unknown.field"
+	CodeNotFound           = "not.found"
+	CodeRequired           = "required"
+	CodeNotNil             = "not.nil"
+	CodeNot                = "not"
+	CodeEqual              = "equal"
+	CodeOneOf              = "oneof"
+	CodeNumberMin          = "min.number"
+	CodeNumberMax          = "max.number"
+	CodeStringMin          = "min.string"
+	CodeStringMax          = "max.string"
+	CodeLenMin             = "min.len"
+	CodeLenMax             = "max.len"
+	CodeLen                = "len"
+	CodeUnique             = "unique"
+	CodeContains           = "contains"
+	CodeSorted             = "sorted"
+	CodePrefix             = "prefix"
+	CodeSuffix             = "suffix"
+	CodeEmail              = "email"
+	CodeRegex              = "regex"
+	CodeLowercase          = "lowercase"
+	CodeUppercase          = "uppercase"
+	CodeIBAN               = "iban"
+	CodeURL                = "url"
+	CodeType               = "type"
+	CodeFormat             = "format"
+	CodeFilesMin           = "min.files"
+	CodeFilesMax           = "max.files"
+	CodeFileSize           = "max.filesize"
+	CodeContentType        = "content.type"
+	CodeImageFormat        = "image.format"
+	CodeImageDimensionsMin = "min.dimensions"
+	CodeImageDimensionsMax = "max.dimensions"
+	CodePDF                = "pdf"
 )
@@ -0,0 +1,103 @@
+package validate
+
+import (
+	"context"
+	"sync"
+)
+
+// validateConcurrent runs the validators on the values with at most workers goroutines.
+// Every worker writes the result of an element to its own slot, the results are merged in index
+// order after all workers are done.
+func validateConcurrent[T any](ctx context.Context, name string, field string, values []T, workers int, validators ...Validator[T]) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	workCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]error, len(values))
+	indexes := make(chan int)
+
+	var (
+		wg             sync.WaitGroup
+		mu             sync.Mutex
+		exception      error
+		exceptionIndex int
+	)
+
+	for range min(workers, len(values)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for i := range indexes {
+				single := func(yield func(int, T) bool) {
+					yield(i, values[i])
+				}
+
+				// validateIndexed returns nil, Errors or an exception. Anything that is not Errors is
+				// handled as exception so it can not be dropped while merging the results.
+				err := validateIndexed(name, field, single, 0, validators...)
+				if _, ok := err.(Errors); err != nil && !ok {
+					mu.Lock()
+					if exception == nil || i < exceptionIndex {
+						exception, exceptionIndex = err, i
+					}
+					mu.Unlock()
+
+					cancel()
+					continue
+				}
+
+				results[i] = err
+			}
+		}()
+	}
+
+feed:
+	for i := range values {
+		select {
+		case indexes <- i:
+		case <-workCtx.Done():
+			break feed
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	if exception != nil {
+		// All elements before the exception were validated, their errors are kept next to the exception
+		// like the sequential validation does.
+		var verrs Errors
+		for _, err := range results[:exceptionIndex] {
+			if err != nil {
+				verrs = verrs.mergeAll(err.(Errors))
+			}
+		}
+
+		if len(verrs) == 0 {
+			return exception
+		}
+
+		errs, exceptions := splitErrors(exception)
+		return AggregateError{Errors: verrs.mergeAll(errs), Exceptions: exceptions}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	var verrs Errors
+	for _, err := range results {
+		if err != nil {
+			verrs = verrs.mergeAll(err.(Errors))
+		}
+	}
+
+	if len(verrs) == 0 {
+		return nil
+	}
+
+	return verrs
+}
@@ -0,0 +1,114 @@
+package validate_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/SLASH2NL/validate"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSliceConcurrent(t *testing.T) {
+	t.Run("errors in index order", func(t *testing.T) {
+		data := make([]int, 1000)
+		for i := range data {
+			data[i] = i
+		}
+
+		err := validate.Slice("numbers", data).Concurrent(context.Background(), 8).Each(func(value int) error {
+			if value%10 == 0 {
+				time.Sleep(time.Duration(1000-value) * time.Microsecond)
+				return &validate.Violation{Code: "fail"}
+			}
+
+			return nil
+		})
+		errs := validate.Collect(err)
+		require.Equal(t, 100, len(errs))
+		for i, err := range errs {
+			require.Equal(t, "numbers.*", err.Path)
+			require.Equal(t, i*10, err.Args["index"])
+		}
+	})
+
+	t.Run("no errors", func(t *testing.T) {
+		err := validate.Slice("numbers", []int{1, 2, 3}).Concurrent(context.Background(), 2).Items("value", validate.MinNumber(1))
+		require.NoError(t, err)
+	})
+
+	t.Run("exception stops validation", func(t *testing.T) {
+		exception := errors.New("some exception")
+
+		var calls atomic.Int64
+		err := validate.Slice("numbers", make([]int, 10000)).Concurrent(context.Background(), 4).Each(func(value int) error {
+			calls.Add(1)
+			return exception
+		})
+		require.ErrorIs(t, err, exception)
+		require.Less(t, calls.Load(), int64(10000))
+	})
+
+	t.Run("context cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := validate.Slice("numbers", make([]int, 100)).Concurrent(ctx, 4).Each(successValidator[int])
+		require.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestSliceConcurrentMatchesSequential(t *testing.T) {
+	type item struct {
+		Name string
+		ID   string
+	}
+
+	exception := errors.New("some exception")
+	failing := func(string) error { return exception }
+	items := []item{{}, {}, {}, {}}
+
+	validators := map[string]validate.Validator[item]{
+		"join all": func(it item) error {
+			return validate.JoinAll(
+				validate.Field("name", it.Name, validate.Required),
+				validate.Field("id", it.ID, failing),
+			)
+		},
+		"wrapped errors": func(it item) error {
+			return fmt.Errorf("wrapped: %w", validate.Field("name", it.Name, validate.Required))
+		},
+	}
+
+	for name, validator := range validators {
+		t.Run(name, func(t *testing.T) {
+			sequential := validate.Slice("items", items).Each(validator)
+			concurrent := validate.Slice("items", items).Concurrent(context.Background(), 2).Each(validator)
+			require.Error(t, concurrent)
+			require.Equal(t, sequential, concurrent)
+		})
+	}
+
+	t.Run("join all keeps the errors of the element next to the exception", func(t *testing.T) {
+		err := validate.Slice("items", items).Concurrent(context.Background(), 2).Each(validators["join all"])
+		require.ErrorIs(t, err, exception)
+		require.False(t, validate.IsValidationError(err))
+
+		var exceptionErr validate.ExceptionError
+		require.ErrorAs(t, err, &exceptionErr)
+		require.Equal(t, "items.0", exceptionErr.ExactPath)
+
+		errs := validate.Collect(err)
+		require.Len(t, errs, 1)
+		require.Equal(t, "items.0.name", errs[0].ExactPath)
+	})
+
+	t.Run("wrapped errors are validation errors", func(t *testing.T) {
+		err := validate.Slice("items", items).Concurrent(context.Background(), 2).Each(validators["wrapped errors"])
+		require.True(t, validate.IsValidationError(err))
+		require.Len(t, validate.Collect(err), len(items))
+	})
+}
@@ -0,0 +1,165 @@
+package validate
+
+import (
+	"bytes"
+	"image"
+	_ "image/gif"  // Register the gif decoder for the image validators.
+	_ "image/jpeg" // Register the jpeg decoder for the image validators.
+	_ "image/png"  // Register the png decoder for the image validators.
+	"io"
+	"io/fs"
+	"math"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"slices"
+)
+
+// Bytes will run the content validators on a byte slice.
+func Bytes(validators ...Validator[io.ReaderAt]) Validator[[]byte] {
+	return func(value []byte) error {
+		return validateContent(bytes.NewReader(value), validators...)
+	}
+}
+
+// FileContent will open the uploaded file and run the content validators on its content.
+func FileContent(validators ...Validator[io.ReaderAt]) Validator[*multipart.FileHeader] {
+	return func(file *multipart.FileHeader) error {
+		f, err := file.Open()
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		return validateContent(f, validators...)
+	}
+}
+
+// MaxBytes validates that the content is not larger than maxBytes.
+func MaxBytes(maxBytes int64) Validator[io.ReaderAt] {
+	return func(r io.ReaderAt) error {
+		size, err := contentSize(r)
+		if err != nil {
+			return err
+		}
+
+		if size > maxBytes {
+			return &Violation{Code: CodeFileSize, Args: Args{"max_bytes": maxBytes}}
+		}
+
+		return nil
+	}
+}
+
+// MIMEType validates the content type detected with http.DetectContentType.
+func MIMEType(accepted ...string) Validator[io.ReaderAt] {
+	return func(r io.ReaderAt) error {
+		buf := make([]byte, 512)
+		n, err := r.ReadAt(buf, 0)
+		if err != nil && err != io.EOF {
+			return err
+		}
+
+		mediaType, _, _ := mime.ParseMediaType(http.DetectContentType(buf[:n]))
+		if !slices.Contains(accepted, mediaType) {
+			return &Violation{Code: CodeContentType, Args: Args{"accepted": accepted, "detected": mediaType}}
+		}
+
+		return nil
+	}
+}
+
+// ImageFormat validates that the content is an image in one of the accepted formats, e.g. png, jpeg or gif.
+func ImageFormat(accepted ...string) Validator[io.ReaderAt] {
+	return func(r io.ReaderAt) error {
+		_, format, err := decodeImageConfig(r)
+		if err != nil || !slices.Contains(accepted, format) {
+			return &Violation{Code: CodeImageFormat, Args: Args{"accepted": accepted}}
+		}
+
+		return nil
+	}
+}
+
+// MinImageDimensions validates that the image is at least width by height pixels.
+func MinImageDimensions(width int, height int) Validator[io.ReaderAt] {
+	return func(r io.ReaderAt) error {
+		config, _, err := decodeImageConfig(r)
+		if err != nil {
+			return &Violation{Code: CodeImageFormat}
+		}
+
+		if config.Width < width || config.Height < height {
+			return &Violation{Code: CodeImageDimensionsMin, Args: Args{"width": width, "height": height}}
+		}
+
+		return nil
+	}
+}
+
+// MaxImageDimensions validates that the image is at most width by height pixels.
+func MaxImageDimensions(width int, height int) Validator[io.ReaderAt] {
+	return func(r io.ReaderAt) error {
+		config, _, err := decodeImageConfig(r)
+		if err != nil {
+			return &Violation{Code: CodeImageFormat}
+		}
+
+		if config.Width > width || config.Height > height {
+			return &Violation{Code: CodeImageDimensionsMax, Args: Args{"width": width, "height": height}}
+		}
+
+		return nil
+	}
+}
+
+// PDF validates that the content starts with the PDF magic header.
+func PDF(r io.ReaderAt) error {
+	magic := []byte("%PDF-")
+	buf := make([]byte, len(magic))
+
+	n, err := r.ReadAt(buf, 0)
+	if err != nil && err != io.EOF {
+		return err
+	}
+
+	if !bytes.Equal(buf[:n], magic) {
+		return &Violation{Code: CodePDF}
+	}
+
+	return nil
+}
+
+func validateContent(r io.ReaderAt, validators ...Validator[io.ReaderAt]) error {
+	violations, err := validate(r, validators...)
+	if err != nil {
+		return err
+	}
+
+	if violations == nil {
+		return nil
+	}
+
+	return Violations(violations)
+}
+
+func decodeImageConfig(r io.ReaderAt) (image.Config, string, error) {
+	return image.DecodeConfig(io.NewSectionReader(r, 0, math.MaxInt64))
+}
+
+// contentSize returns the size of the content.
+// It uses Size or Stat when available and otherwise reads the content.
+func contentSize(r io.ReaderAt) (int64, error) {
+	switch r := r.(type) {
+	case interface{ Size() int64 }:
+		return r.Size(), nil
+	case interface{ Stat() (fs.FileInfo, error) }:
+		info, err := r.Stat()
+		if err != nil {
+			return 0, err
+		}
+		return info.Size(), nil
+	}
+
+	return io.Copy(io.Discard, io.NewSectionReader(r, 0, math.MaxInt64))
+}
@@ -0,0 +1,69 @@
+package validate_test
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"testing"
+
+	"github.com/SLASH2NL/validate"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContent(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 20, 10))))
+	img := buf.Bytes()
+	pdf := []byte("%PDF-1.7\n...")
+
+	t.Run("max bytes", func(t *testing.T) {
+		require.NoError(t, validate.Bytes(validate.MaxBytes(int64(len(pdf))))(pdf))
+
+		err := validate.Bytes(validate.MaxBytes(4))(pdf)
+		violations, ok := err.(validate.Violations)
+		require.True(t, ok)
+		require.Equal(t, validate.CodeFileSize, violations[0].Code)
+		require.Equal(t, int64(4), violations[0].Args["max_bytes"])
+	})
+
+	t.Run("mime type", func(t *testing.T) {
+		require.NoError(t, validate.Bytes(validate.MIMEType("image/png"))(img))
+		require.NoError(t, validate.Bytes(validate.MIMEType("application/pdf"))(pdf))
+
+		err := validate.Field("file", pdf, validate.Bytes(validate.MIMEType("image/png", "image/jpeg")))
+		errs := validate.Collect(err)
+		require.Equal(t, 1, len(errs))
+		require.Equal(t, validate.CodeContentType, errs[0].Violations[0].Code)
+		require.Equal(t, "application/pdf", errs[0].Violations[0].Args["detected"])
+	})
+
+	t.Run("image", func(t *testing.T) {
+		require.NoError(t, validate.Bytes(validate.ImageFormat("png"), validate.MaxImageDimensions(20, 10))(img))
+
+		err := validate.Field("file", img, validate.Bytes(
+			validate.ImageFormat("jpeg"),
+			validate.MinImageDimensions(30, 10),
+			validate.MaxImageDimensions(10, 10),
+		))
+		errs := validate.Collect(err)
+		require.Equal(t, 1, len(errs))
+		require.Equal(t, 3, len(errs[0].Violations))
+		require.Equal(t, validate.CodeImageFormat, errs[0].Violations[0].Code)
+		require.Equal(t, validate.CodeImageDimensionsMin, errs[0].Violations[1].Code)
+		require.Equal(t, validate.CodeImageDimensionsMax, errs[0].Violations[2].Code)
+		require.Equal(t, 10, errs[0].Violations[2].Args["width"])
+
+		err = validate.Bytes(validate.MaxImageDimensions(10, 10))(pdf)
+		require.Equal(t, validate.CodeImageFormat, err.(validate.Violations)[0].Code)
+	})
+
+	t.Run("pdf", func(t *testing.T) {
+		require.NoError(t, validate.Bytes(validate.PDF)(pdf))
+
+		err := validate.Bytes(validate.PDF)(img)
+		require.Equal(t, validate.CodePDF, err.(validate.Violations)[0].Code)
+
+		err = validate.Bytes(validate.PDF)([]byte("%P"))
+		require.Equal(t, validate.CodePDF, err.(validate.Violations)[0].Code)
+	})
+}
@@ -0,0 +1,206 @@
+package validate
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// CSVHeaderPrefix is the path prefix for errors in the header of a CSV file.
+const CSVHeaderPrefix = "header"
+
+// CSVRowPrefix is the path prefix for errors in the rows of a CSV file.
+const CSVRowPrefix = "row"
+
+// CSV will validate the rows of a CSV file by mapping the header names to column rules.
+// Errors in cells are reported at row.<n>.<column> where n is the zero based data row, the line
+// number in the file is added to the args as line.
+func CSV() CSVValidator {
+	return CSVValidator{}
+}
+
+type CSVValidator struct {
+	columns  []csvColumn
+	required []string
+	rejected io.Writer
+}
+
+type csvColumn struct {
+	header     string
+	validators []Validator[string]
+}
+
+// CSVSummary contains the statistics of a validated CSV file.
+type CSVSummary struct {
+	// Rows is the number of data rows.
+	Rows int
+	// RejectedRows is the number of data rows with at least one error.
+	RejectedRows int
+	// Codes contains the number of violations per column and code.
+	Codes map[string]map[string]int
+}
+
+// Column returns a CSVValidator that runs the validators on every cell in the column with the header.
+// Columns that are not present in the file are skipped, use RequiredHeaders to require them.
+func (v CSVValidator) Column(header string, validators ...Validator[string]) CSVValidator {
+	v.columns = append(slices.Clip(v.columns), csvColumn{header: header, validators: validators})
+	return v
+}
+
+// RequiredHeaders returns a CSVValidator that reports a required violation at header.<name> for
+// every header that is missing in the file.
+func (v CSVValidator) RequiredHeaders(headers ...string) CSVValidator {
+	v.required = append(slices.Clip(v.required), headers...)
+	return v
+}
+
+// Rejected returns a CSVValidator that writes every rejected row to w as CSV.
+// The header and rows get an extra errors column listing the violations of the row.
+func (v CSVValidator) Rejected(w io.Writer) CSVValidator {
+	v.rejected = w
+	return v
+}
+
+// Validate reads all rows from the reader and runs the column rules.
+// It returns the summary and the validation errors of all rows. Errors from reading the file are
+// returned as exception.
+func (v CSVValidator) Validate(r *csv.Reader) (CSVSummary, error) {
+	summary := CSVSummary{Codes: make(map[string]map[string]int)}
+
+	header, err := r.Read()
+	if err != nil {
+		return summary, err
+	}
+
+	var verrs Errors
+	for _, name := range v.required {
+		if !slices.Contains(header, name) {
+			path := joinPath(CSVHeaderPrefix, name)
+			verrs = append(verrs, Error{
+				Path:       path,
+				ExactPath:  path,
+				Violations: []Violation{{Code: CodeRequired}},
+			})
+		}
+	}
+
+	var rejected *csv.Writer
+	if v.rejected != nil {
+		rejected = csv.NewWriter(v.rejected)
+		if err := rejected.Write(append(slices.Clone(header), "errors")); err != nil {
+			return summary, err
+		}
+	}
+
+	for n := 0; ; n++ {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		// A row with the wrong number of fields is reported as violation, the reader still returns the record.
+		fieldCount := errors.Is(err, csv.ErrFieldCount)
+		if err != nil && !fieldCount {
+			return summary, err
+		}
+
+		summary.Rows++
+		line, _ := r.FieldPos(0)
+		args := func() Args { return Args{"row": n, "line": line} }
+
+		var rowErrs Errors
+		var notes []string
+		count := func(column string, errs Errors) {
+			var codes []string
+			for _, e := range errs {
+				for _, violation := range e.Violations {
+					if summary.Codes[column] == nil {
+						summary.Codes[column] = make(map[string]int)
+					}
+					summary.Codes[column][violation.Code]++
+					codes = append(codes, violation.Code)
+				}
+			}
+
+			rowErrs = rowErrs.mergeAll(errs)
+			notes = append(notes, column+": "+strings.Join(codes, ", "))
+		}
+
+		if fieldCount {
+			count(CSVRowPrefix, Errors{{
+				Path:       joinPath(CSVRowPrefix, "*"),
+				ExactPath:  joinPath(CSVRowPrefix, strconv.Itoa(n)),
+				Violations: []Violation{{Code: CodeLen, Args: Args{"len": len(header)}}},
+				Args:       args(),
+			}})
+		}
+
+		for _, column := range v.columns {
+			i := slices.Index(header, column.header)
+			if i == -1 || i >= len(record) {
+				continue
+			}
+
+			path := joinPath(CSVRowPrefix, "*", column.header)
+			exactPath := joinPath(CSVRowPrefix, strconv.Itoa(n), column.header)
+
+			violations, errs, exceptions := validateNested(record[i], column.validators...)
+
+			// Errors returned by the column rules are relative to the cell.
+			cellErrs := errs.mapErrors(func(e Error) Error {
+				e.Path = joinPath(path, e.Path)
+				e.ExactPath = joinPath(exactPath, e.ExactPath)
+				e.Args = Merge(e.Args, args())
+				return e
+			})
+
+			if len(violations) > 0 {
+				cellErrs = cellErrs.merge(Error{
+					Path:       path,
+					ExactPath:  exactPath,
+					Violations: violations,
+					Args:       args(),
+				})
+			}
+
+			if len(exceptions) > 0 {
+				err := nestedException(verrs.mergeAll(rowErrs).mergeAll(cellErrs), exceptions, path, exactPath, args())
+				reportPanics(err)
+				return summary, err
+			}
+
+			if len(cellErrs) > 0 {
+				count(column.header, cellErrs)
+			}
+		}
+
+		if len(rowErrs) == 0 {
+			continue
+		}
+
+		summary.RejectedRows++
+		verrs = append(verrs, rowErrs...)
+
+		if rejected != nil {
+			if err := rejected.Write(append(slices.Clone(record), strings.Join(notes, "; "))); err != nil {
+				return summary, err
+			}
+		}
+	}
+
+	if rejected != nil {
+		rejected.Flush()
+		if err := rejected.Error(); err != nil {
+			return summary, err
+		}
+	}
+
+	if len(verrs) == 0 {
+		return summary, nil
+	}
+
+	return summary, verrs
+}
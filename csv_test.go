@@ -0,0 +1,134 @@
+package validate_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/SLASH2NL/validate"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCSV(t *testing.T) {
+	input := `name,email,iban
+John,john@example.org,NL91ABNA0417164300
+,invalid,NL91ABNA0417164300
+Jane,jane@example.org,invalid
+`
+
+	var rejected bytes.Buffer
+	summary, err := validate.CSV().
+		RequiredHeaders("name", "email", "iban", "country").
+		Column("name", validate.Required).
+		Column("email", validate.Email).
+		Column("iban", validate.IBAN).
+		Rejected(&rejected).
+		Validate(csv.NewReader(strings.NewReader(input)))
+
+	require.Equal(t, 3, summary.Rows)
+	require.Equal(t, 2, summary.RejectedRows)
+	require.Equal(t, map[string]map[string]int{
+		"name":  {validate.CodeRequired: 1},
+		"email": {validate.CodeEmail: 1},
+		"iban":  {validate.CodeIBAN: 1},
+	}, summary.Codes)
+
+	errs := validate.Collect(err)
+	require.Equal(t, 4, len(errs))
+	require.Equal(t, "header.country", errs[0].ExactPath)
+	require.Equal(t, validate.CodeRequired, errs[0].Violations[0].Code)
+	require.Equal(t, "row.*.name", errs[1].Path)
+	require.Equal(t, "row.1.name", errs[1].ExactPath)
+	require.Equal(t, 3, errs[1].Args["line"])
+	require.Equal(t, "row.1.email", errs[2].ExactPath)
+	require.Equal(t, "row.2.iban", errs[3].ExactPath)
+	require.Equal(t, 4, errs[3].Args["line"])
+
+	require.Equal(t, `name,email,iban,errors
+,invalid,NL91ABNA0417164300,name: required; email: email
+Jane,jane@example.org,invalid,iban: iban
+`, rejected.String())
+}
+
+func TestCSVValid(t *testing.T) {
+	summary, err := validate.CSV().
+		Column("email", validate.Email).
+		Validate(csv.NewReader(strings.NewReader("email\njohn@example.org\n")))
+	require.NoError(t, err)
+	require.Equal(t, 1, summary.Rows)
+	require.Equal(t, 0, summary.RejectedRows)
+}
+
+func TestCSVFieldCount(t *testing.T) {
+	input := `name,email
+John,john@example.org
+Jane
+,invalid,extra
+`
+
+	summary, err := validate.CSV().
+		Column("name", validate.Required).
+		Column("email", validate.Email).
+		Validate(csv.NewReader(strings.NewReader(input)))
+	require.Equal(t, 3, summary.Rows)
+	require.Equal(t, 2, summary.RejectedRows)
+
+	errs := validate.Collect(err)
+	require.Equal(t, 4, len(errs))
+	require.Equal(t, "row.1", errs[0].ExactPath)
+	require.Equal(t, validate.CodeLen, errs[0].Violations[0].Code)
+	require.Equal(t, 2, errs[0].Violations[0].Args["len"])
+	require.Equal(t, "row.2", errs[1].ExactPath)
+	require.Equal(t, 4, errs[1].Args["line"])
+	require.Equal(t, "row.2.name", errs[2].ExactPath)
+	require.Equal(t, "row.2.email", errs[3].ExactPath)
+}
+
+func TestCSVNestedErrors(t *testing.T) {
+	split := func(value string) error {
+		first, last, _ := strings.Cut(value, " ")
+		return validate.Join(
+			validate.Field("first", first, validate.Required),
+			validate.Field("last", last, validate.Required),
+		)
+	}
+
+	summary, err := validate.CSV().
+		Column("name", split).
+		Validate(csv.NewReader(strings.NewReader("name\nJohn Deer\nJohn\n")))
+	require.Equal(t, 1, summary.RejectedRows)
+	require.Equal(t, map[string]map[string]int{"name": {validate.CodeRequired: 1}}, summary.Codes)
+
+	errs := validate.Collect(err)
+	require.Equal(t, 1, len(errs))
+	require.Equal(t, "row.*.name.last", errs[0].Path)
+	require.Equal(t, "row.1.name.last", errs[0].ExactPath)
+	require.Equal(t, 3, errs[0].Args["line"])
+}
+
+func TestCSVException(t *testing.T) {
+	exception := errors.New("some exception")
+	lookup := func(value string) error {
+		if value == "fail" {
+			return exception
+		}
+
+		return nil
+	}
+
+	_, err := validate.CSV().
+		Column("email", validate.Email).
+		Column("id", lookup).
+		Validate(csv.NewReader(strings.NewReader("email,id\ninvalid,1\ntest@example.org,fail\n")))
+	require.ErrorIs(t, err, exception)
+
+	var exceptionErr validate.ExceptionError
+	require.ErrorAs(t, err, &exceptionErr)
+	require.Equal(t, "row.1.id", exceptionErr.ExactPath)
+
+	errs := validate.Collect(err)
+	require.Equal(t, 1, len(errs))
+	require.Equal(t, "row.0.email", errs[0].ExactPath)
+}
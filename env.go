@@ -0,0 +1,61 @@
+package validate
+
+import (
+	"maps"
+	"strings"
+)
+
+// Env will validate configuration variables from a list of key=value pairs as returned by os.Environ.
+func Env(environ []string) EnvValidator {
+	vars := make(map[string]string, len(environ))
+	for _, kv := range environ {
+		key, value, _ := strings.Cut(kv, "=")
+		vars[key] = value
+	}
+
+	return EnvValidator{vars: vars}
+}
+
+// EnvMap will validate configuration variables from the given map.
+func EnvMap(vars map[string]string) EnvValidator {
+	return EnvValidator{vars: vars}
+}
+
+type EnvValidator struct {
+	vars map[string]string
+}
+
+// Defaults returns a new EnvValidator that uses the default value for every variable that is not set.
+func (v EnvValidator) Defaults(defaults map[string]string) EnvValidator {
+	vars := maps.Clone(defaults)
+	if vars == nil {
+		vars = make(map[string]string)
+	}
+	maps.Copy(vars, v.vars)
+
+	return EnvValidator{vars: vars}
+}
+
+// Lookup returns the value of the variable and whether it is set.
+func (v EnvValidator) Lookup(name string) (string, bool) {
+	value, ok := v.vars[name]
+	return value, ok
+}
+
+// Var runs the validators on the raw value of the variable.
+// Errors are reported with the variable name as path.
+func (v EnvValidator) Var(name string, validators ...Validator[string]) error {
+	return Field(name, v.vars[name], validators...)
+}
+
+// ParseVar parses the variable and runs the validators on the parsed value, see Parse.
+// If the variable is not set or empty a required violation is returned.
+func ParseVar[T any](v EnvValidator, name string, parser Parser[T], validators ...Validator[T]) (T, error) {
+	value := v.vars[name]
+	if value == "" {
+		var zero T
+		return zero, Field(name, value, Required)
+	}
+
+	return Parse(name, value, parser, validators...)
+}
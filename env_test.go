@@ -0,0 +1,58 @@
+package validate_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/SLASH2NL/validate"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnv(t *testing.T) {
+	env := validate.Env([]string{
+		"BASE_URL=example.org",
+		"PORT=70000",
+		"TIMEOUT=5s",
+		"MODE=prod=eu",
+	})
+
+	port, portErr := validate.ParseVar(env, "PORT", validate.ParseInt[int], validate.MinNumber(1), validate.MaxNumber(65535))
+	timeout, timeoutErr := validate.ParseVar(env, "TIMEOUT", validate.ParseDuration)
+	_, secretErr := validate.ParseVar(env, "SECRET_COUNT", validate.ParseInt[int])
+
+	err := validate.Join(
+		env.Var("BASE_URL", validate.URL),
+		portErr,
+		timeoutErr,
+		secretErr,
+		env.Var("MODE", validate.OneOf("prod=eu", "dev")),
+	)
+	require.Equal(t, 70000, port)
+	require.Equal(t, 5*time.Second, timeout)
+
+	errs := validate.Collect(err)
+	require.Equal(t, 3, len(errs))
+	require.Equal(t, "BASE_URL", errs[0].ExactPath)
+	require.Equal(t, validate.CodeURL, errs[0].Violations[0].Code)
+	require.Equal(t, "PORT", errs[1].ExactPath)
+	require.Equal(t, validate.CodeNumberMax, errs[1].Violations[0].Code)
+	require.Equal(t, "SECRET_COUNT", errs[2].ExactPath)
+	require.Equal(t, validate.CodeRequired, errs[2].Violations[0].Code)
+
+	require.Equal(t, "BASE_URL: url\nPORT: max.number (max=65535)\nSECRET_COUNT: required\n", validate.Report(err))
+}
+
+func TestEnvDefaults(t *testing.T) {
+	env := validate.EnvMap(map[string]string{"PORT": "8080"}).Defaults(map[string]string{
+		"PORT": "80",
+		"HOST": "localhost",
+	})
+
+	host, ok := env.Lookup("HOST")
+	require.True(t, ok)
+	require.Equal(t, "localhost", host)
+
+	port, err := validate.ParseVar(env, "PORT", validate.ParseInt[int])
+	require.NoError(t, err)
+	require.Equal(t, 8080, port)
+}
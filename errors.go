@@ -1,19 +1,33 @@
 package validate
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
 	"maps"
+	"slices"
+	"strconv"
 	"strings"
 )
 
 // IsValidationError returns true if the given error is of type Error or Errors or if it contains a wrapped Error or Errors.
+// It returns false if the error tree also contains exceptions, like an AggregateError returned by JoinAll,
+// use Exceptions to retrieve them.
 func IsValidationError(err error) bool {
 	if isValidationError(err) {
 		return true
 	}
 
-	// The error could be wrapped, try to unwrap it.
+	if !containsValidationError(err) {
+		return false
+	}
+
+	_, exceptions := splitErrors(err)
+	return len(exceptions) == 0
+}
+
+// containsValidationError returns true if an Error or Errors is found in the error tree of err.
+func containsValidationError(err error) bool {
 	var errs Errors
 	if errors.As(err, &errs) {
 		return true
@@ -32,6 +46,55 @@ func isValidationError(err error) bool {
 	return false
 }
 
+// Exceptions returns all errors in the error tree of err that are not validation errors.
+// The tree is traversed through errors.Join results, AggregateError and other errors that implement
+// Unwrap() []error. A wrapped error is only traversed if it contains a validation error, otherwise
+// the wrapping error itself is returned as exception.
+func Exceptions(err error) []error {
+	_, exceptions := splitErrors(err)
+	return exceptions
+}
+
+// splitErrors traverses the error tree and separates the validation errors from the exceptions.
+func splitErrors(err error) (Errors, []error) {
+	var verrs Errors
+	var exceptions []error
+
+	var walk func(err error)
+	walk = func(err error) {
+		switch e := err.(type) {
+		case nil:
+		case Error:
+			verrs = verrs.merge(e)
+		case Errors:
+			verrs = verrs.mergeAll(e)
+		case AggregateError:
+			verrs = verrs.mergeAll(e.Errors)
+			for _, exception := range e.Exceptions {
+				walk(exception)
+			}
+		case ExceptionError:
+			// The exception is already annotated with its path, it is kept as a whole.
+			exceptions = append(exceptions, err)
+		case interface{ Unwrap() []error }:
+			for _, child := range e.Unwrap() {
+				walk(child)
+			}
+		case interface{ Unwrap() error }:
+			if containsValidationError(err) {
+				walk(e.Unwrap())
+			} else {
+				exceptions = append(exceptions, err)
+			}
+		default:
+			exceptions = append(exceptions, err)
+		}
+	}
+	walk(err)
+
+	return verrs, exceptions
+}
+
 type Errors []Error
 
 // merge merges the given error into the errors.
@@ -57,6 +120,95 @@ func (e Errors) mergeAll(errs Errors) Errors {
 	return e
 }
 
+// Len returns the number of errors.
+func (e Errors) Len() int {
+	return len(e)
+}
+
+// ByExactPath returns the error with the given exact path.
+func (e Errors) ByExactPath(path string) (Error, bool) {
+	for _, err := range e {
+		if err.ExactPath == path {
+			return err, true
+		}
+	}
+
+	return Error{}, false
+}
+
+// ByPath returns the errors that match the path. The path may contain * segments that match any
+// single segment, so items.*.price returns the errors of items.0.price and items.1.price.
+func (e Errors) ByPath(path string) Errors {
+	pattern := strings.Split(path, ".")
+
+	return e.Filter(func(err Error) bool {
+		return err.Path == path || matchSegments(pattern, strings.Split(err.ExactPath, "."), false)
+	})
+}
+
+// Without returns the errors that are not under the path prefix. The prefix may contain * segments,
+// e.g. internal.* removes internal.token and internal.user.id but keeps internal.
+func (e Errors) Without(prefix string) Errors {
+	pattern := strings.Split(prefix, ".")
+
+	return e.Filter(func(err Error) bool {
+		return !matchSegments(pattern, strings.Split(err.ExactPath, "."), true)
+	})
+}
+
+// Filter returns the errors for which fn returns true.
+func (e Errors) Filter(fn func(Error) bool) Errors {
+	var errs Errors
+	for _, err := range e {
+		if fn(err) {
+			errs = append(errs, err)
+		}
+	}
+
+	return errs
+}
+
+// HasCode returns true if any error has a violation with the code.
+func (e Errors) HasCode(code string) bool {
+	return slices.ContainsFunc(e, func(err Error) bool { return err.HasCode(code) })
+}
+
+// Codes returns the unique violation codes of all errors in order of appearance.
+func (e Errors) Codes() []string {
+	var codes []string
+	for _, err := range e {
+		for _, violation := range err.Violations {
+			if !slices.Contains(codes, violation.Code) {
+				codes = append(codes, violation.Code)
+			}
+		}
+	}
+
+	return codes
+}
+
+// Sort sorts the errors in place by exact path and then by path.
+// Segments are compared one by one, numeric segments such as slice indexes are compared by value
+// so items.2 is sorted before items.10.
+func (e Errors) Sort() {
+	slices.SortStableFunc(e, func(a, b Error) int {
+		return cmp.Or(
+			comparePaths(a.ExactPath, b.ExactPath),
+			comparePaths(a.Path, b.Path),
+		)
+	})
+}
+
+// Unwrap returns every Error so the errors can be traversed with errors.Is and errors.As.
+func (e Errors) Unwrap() []error {
+	errs := make([]error, len(e))
+	for i, err := range e {
+		errs[i] = err
+	}
+
+	return errs
+}
+
 func (e Errors) Error() string {
 	var errs []string
 
@@ -76,6 +228,84 @@ func (e Errors) mapErrors(fn func(Error) Error) Errors {
 	return e
 }
 
+// AggregateError is returned by JoinAll and FieldAll if one or more validators returned an exception.
+// It keeps the validation errors that were collected next to the exceptions. Both are returned by Unwrap
+// so errors.Is and errors.As can be used to find a specific exception or the Errors.
+type AggregateError struct {
+	Errors     Errors
+	Exceptions []error
+}
+
+func (e AggregateError) Error() string {
+	var exceptions []string
+
+	for _, err := range e.Exceptions {
+		exceptions = append(exceptions, err.Error())
+	}
+
+	return fmt.Sprintf("exceptions: %v, %s", exceptions, e.Errors.Error())
+}
+
+func (e AggregateError) Unwrap() []error {
+	errs := slices.Clone(e.Exceptions)
+	if len(e.Errors) > 0 {
+		errs = append(errs, e.Errors)
+	}
+
+	return errs
+}
+
+// ExceptionError annotates an exception with the path where it occurred.
+// It wraps exceptions returned by a validator inside Slice, Map, Seq or CSV and a PanicError of a validator
+// that is wrapped by Safe inside Field. The original exception can be retrieved with errors.Is, errors.As or Unwrap.
+type ExceptionError struct {
+	Path      string
+	ExactPath string
+	Args      Args
+	Err       error
+}
+
+func (e ExceptionError) Error() string {
+	return fmt.Sprintf("exception for exact path: %s, path: %s, args: %v: %v", redactPath(e.Path, e.ExactPath), e.Path, e.Args.Redacted(false), e.Err)
+}
+
+func (e ExceptionError) Unwrap() error {
+	return e.Err
+}
+
+// wrapException annotates the exception with the path where it occurred.
+// If the exception is already annotated by a nested Slice or Map its paths are prefixed.
+func wrapException(err error, path string, exactPath string, args Args) error {
+	if e, ok := err.(ExceptionError); ok {
+		e.Path = joinPath(path, e.Path)
+		e.ExactPath = joinPath(exactPath, e.ExactPath)
+		e.Args = Merge(e.Args, args)
+		return e
+	}
+
+	return ExceptionError{
+		Path:      path,
+		ExactPath: exactPath,
+		Args:      args,
+		Err:       err,
+	}
+}
+
+// nestedException annotates the exceptions of a value inside Slice, Map, Seq or CSV with the path of the value.
+// The validation errors collected so far are kept next to the exceptions in an AggregateError.
+func nestedException(errs Errors, exceptions []error, path string, exactPath string, args Args) error {
+	wrapped := make([]error, 0, len(exceptions))
+	for _, exception := range exceptions {
+		wrapped = append(wrapped, wrapException(exception, path, exactPath, maps.Clone(args)))
+	}
+
+	if len(errs) == 0 && len(wrapped) == 1 {
+		return wrapped[0]
+	}
+
+	return AggregateError{Errors: errs, Exceptions: wrapped}
+}
+
 type Error struct {
 	Path       string
 	ExactPath  string
@@ -83,17 +313,41 @@ type Error struct {
 	Violations []Violation
 }
 
+// HasCode returns true if the error has a violation with the code.
+func (e Error) HasCode(code string) bool {
+	return slices.ContainsFunc(e.Violations, func(v Violation) bool { return v.Code == code })
+}
+
 func (e Error) Error() string {
-	return fmt.Sprintf("validation error for exact path: %s, path: %s, args: %v, violations: %v", e.ExactPath, e.Path, e.Args, e.Violations)
+	return fmt.Sprintf("validation error for exact path: %s, path: %s, args: %v, violations: %v", redactPath(e.Path, e.ExactPath), e.Path, e.Args.Redacted(false), e.Violations)
 }
 
 type Violation struct {
-	Code string
-	Args Args
+	Code     string
+	Args     Args
+	Severity Severity `json:",omitempty"`
+	// Message is an optional default message in English that can be shown if there is no translation for the code.
+	Message string `json:",omitempty"`
+	// Hint is an optional machine readable hint on how to fix the value, e.g. the expected time layout.
+	Hint string `json:",omitempty"`
+	// Cause is the optional underlying error that caused the violation, e.g. the error of mail.ParseAddress.
+	// It is not rendered as JSON as it can contain the validated value.
+	Cause error `json:"-"`
+	// Sensitive marks the args as sensitive so they are masked when rendered, see Sensitive.
+	Sensitive bool `json:"-"`
+}
+
+// Unwrap returns the cause of the violation.
+func (v Violation) Unwrap() error {
+	return v.Cause
 }
 
 func (v Violation) Error() string {
-	return fmt.Sprintf("violation code: %s, args: %v", v.Code, v.Args)
+	if v.Severity != SeverityError {
+		return fmt.Sprintf("violation code: %s, severity: %s, args: %v", v.Code, v.Severity, v.RedactedArgs())
+	}
+
+	return fmt.Sprintf("violation code: %s, args: %v", v.Code, v.RedactedArgs())
 }
 
 type Violations []Violation
@@ -141,6 +395,48 @@ func Merge(a Args, b Args) Args {
 	return dst
 }
 
+// Report renders the validation errors in err as a human readable report with one line per violation.
+// This is useful for printing configuration problems at startup. Exceptions next to the validation errors
+// are added with one line per exception, errors that do not contain validation errors are rendered as is.
+func Report(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	errs, exceptions := splitErrors(err)
+	if len(errs) == 0 {
+		return err.Error()
+	}
+
+	var b strings.Builder
+	for _, e := range errs {
+		for _, v := range e.Violations {
+			b.WriteString(redactPath(e.Path, e.ExactPath) + ": " + v.Code)
+
+			args := Merge(e.Args.Redacted(false), v.RedactedArgs())
+			if len(args) > 0 {
+				var pairs []string
+				for _, key := range slices.Sorted(maps.Keys(args)) {
+					pairs = append(pairs, fmt.Sprintf("%s=%v", key, args[key]))
+				}
+				b.WriteString(" (" + strings.Join(pairs, ", ") + ")")
+			}
+
+			if v.Severity != SeverityError {
+				b.WriteString(" [" + v.Severity.String() + "]")
+			}
+
+			b.WriteString("\n")
+		}
+	}
+
+	for _, exception := range exceptions {
+		b.WriteString("exception: " + exception.Error() + "\n")
+	}
+
+	return b.String()
+}
+
 // LastPathSegment will return the last segment of the given path.
 // It assumes the path is separated by dots.
 func LastPathSegment(s string) string {
@@ -171,10 +467,65 @@ func mapError(err error, mapFunc func(Error) Error) error {
 	}
 }
 
-func prefixPath(path string, prefix string) string {
-	if path == "" {
-		return prefix
+// comparePaths compares two dot separated paths segment by segment.
+// Numeric segments are compared by value and are sorted before other segments.
+func comparePaths(a string, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		an, aerr := strconv.Atoi(as[i])
+		bn, berr := strconv.Atoi(bs[i])
+
+		var c int
+		switch {
+		case aerr == nil && berr == nil:
+			c = cmp.Compare(an, bn)
+		case aerr == nil:
+			c = -1
+		case berr == nil:
+			c = 1
+		default:
+			c = cmp.Compare(as[i], bs[i])
+		}
+
+		if c != 0 {
+			return c
+		}
+	}
+
+	return cmp.Compare(len(as), len(bs))
+}
+
+// matchSegments matches the path segments against the pattern segments where * matches any segment.
+// If prefix is true the path may have more segments than the pattern.
+func matchSegments(pattern []string, path []string, prefix bool) bool {
+	if len(path) < len(pattern) || (!prefix && len(path) != len(pattern)) {
+		return false
+	}
+
+	for i, segment := range pattern {
+		if segment != "*" && segment != path[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// joinPath joins the non empty segments with a dot.
+func joinPath(segments ...string) string {
+	var b strings.Builder
+
+	for _, segment := range segments {
+		if segment == "" {
+			continue
+		}
+
+		if b.Len() > 0 {
+			b.WriteByte('.')
+		}
+		b.WriteString(segment)
 	}
 
-	return prefix + "." + path
+	return b.String()
 }
@@ -1,6 +1,8 @@
 package validate_test
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"slices"
 	"testing"
@@ -23,6 +25,22 @@ func TestIsValidationError(t *testing.T) {
 	err = validate.Errors{}
 	wrap := fmt.Errorf("wrapped: %w", err)
 	require.True(t, validate.IsValidationError(wrap))
+
+	// A tree with exceptions is not a validation error.
+	aggregate := validate.AggregateError{Errors: validate.Errors{{Path: "name"}}, Exceptions: []error{errors.New("some exception")}}
+	require.False(t, validate.IsValidationError(aggregate))
+	require.False(t, validate.IsValidationError(fmt.Errorf("wrapped: %w", aggregate)))
+	require.False(t, validate.IsValidationError(errors.Join(validate.Errors{{Path: "name"}}, errors.New("some exception"))))
+}
+
+func TestReportExceptions(t *testing.T) {
+	err := validate.JoinAll(
+		validate.Field("name", "", validate.Required),
+		errors.New("some exception"),
+	)
+	require.Equal(t, "name: required\nexception: some exception\n", validate.Report(err))
+
+	require.Equal(t, "some exception", validate.Report(errors.New("some exception")))
 }
 
 func TestLastSegment(t *testing.T) {
@@ -89,7 +107,7 @@ func TestMapError(t *testing.T) {
 		require.NotNil(t, err)
 		errs := validate.Collect(err)
 		require.Equal(t, 1, len(errs))
-		require.Equal(t, "persons.person.name", errs[0].Path)
+		require.Equal(t, "persons.*.person.name", errs[0].Path)
 		require.Equal(t, "persons.some-id.person.name", errs[0].ExactPath)
 	})
 
@@ -111,9 +129,9 @@ func TestMapError(t *testing.T) {
 		require.NotNil(t, err)
 		errs := validate.Collect(err)
 		require.Equal(t, 2, len(errs))
-		require.Equal(t, "persons.person.name", errs[0].Path)
+		require.Equal(t, "persons.*.person.name", errs[0].Path)
 		require.Equal(t, "persons.some-id.person.name", errs[0].ExactPath)
-		require.Equal(t, "persons.person.address", errs[1].Path)
+		require.Equal(t, "persons.*.person.address", errs[1].Path)
 		require.Equal(t, "persons.some-id.person.address", errs[1].ExactPath)
 	})
 
@@ -140,3 +158,174 @@ func TestMapError(t *testing.T) {
 		require.Equal(t, "persons.0.person.address", errs[1].ExactPath)
 	})
 }
+
+func TestErrorsSort(t *testing.T) {
+	errs := validate.Errors{
+		{Path: "items.*.name", ExactPath: "items.10.name"},
+		{Path: "name", ExactPath: "name"},
+		{Path: "items.*.name", ExactPath: "items.2.name"},
+		{Path: "items.*", ExactPath: "items.2"},
+		{Path: "items.name", ExactPath: "items.name"},
+	}
+
+	errs.Sort()
+
+	var paths []string
+	for _, err := range errs {
+		paths = append(paths, err.ExactPath)
+	}
+	require.Equal(t, []string{"items.2", "items.2.name", "items.10.name", "items.name", "name"}, paths)
+}
+
+func TestErrorTrees(t *testing.T) {
+	exception := errors.New("some exception")
+	name := validate.Field("name", "", failValidatorWithCode[string]("name.fail"))
+	iban := validate.Field("iban", "", failValidatorWithCode[string]("iban.fail"))
+	email := validate.Field("email", "", failValidatorWithCode[string]("email.fail"))
+
+	t.Run("collect", func(t *testing.T) {
+		err := errors.Join(name, fmt.Errorf("wrapped: %w", errors.Join(iban, email)))
+
+		errs := validate.Collect(err)
+		require.Equal(t, 3, len(errs))
+		require.Equal(t, "name", errs[0].Path)
+		require.Equal(t, "iban", errs[1].Path)
+		require.Equal(t, "email", errs[2].Path)
+		require.Empty(t, validate.Exceptions(err))
+	})
+
+	t.Run("exceptions", func(t *testing.T) {
+		wrapped := fmt.Errorf("lookup: %w", exception)
+		err := errors.Join(name, wrapped, validate.JoinAll(iban, exception))
+
+		require.Equal(t, []error{wrapped, exception}, validate.Exceptions(err))
+		require.Equal(t, 2, len(validate.Collect(err)))
+	})
+
+	t.Run("join", func(t *testing.T) {
+		err := validate.Join(errors.Join(name, iban), email)
+		errs := validate.Collect(err)
+		require.Equal(t, 3, len(errs))
+
+		joined := errors.Join(name, exception)
+		require.Equal(t, joined, validate.Join(joined, email))
+	})
+
+	t.Run("errors unwrap", func(t *testing.T) {
+		err := validate.Join(name, iban)
+
+		var single validate.Error
+		require.ErrorAs(t, err, &single)
+		require.Equal(t, "name", single.Path)
+	})
+}
+
+func TestExceptionError(t *testing.T) {
+	exception := errors.New("some exception")
+	failing := func(value string) error {
+		if value == "fail" {
+			return exception
+		}
+
+		return nil
+	}
+
+	t.Run("slice", func(t *testing.T) {
+		err := validate.Slice("items", []string{"ok", "fail"}).Items("name", failing)
+		require.ErrorIs(t, err, exception)
+		require.False(t, validate.IsValidationError(err))
+
+		var exceptionErr validate.ExceptionError
+		require.ErrorAs(t, err, &exceptionErr)
+		require.Equal(t, "items.*.name", exceptionErr.Path)
+		require.Equal(t, "items.1.name", exceptionErr.ExactPath)
+		require.Equal(t, 1, exceptionErr.Args["index"])
+	})
+
+	t.Run("map", func(t *testing.T) {
+		err := validate.Map("items", map[string]string{"a": "ok", "b": "fail"}).Values("name", failing)
+		require.ErrorIs(t, err, exception)
+
+		var exceptionErr validate.ExceptionError
+		require.ErrorAs(t, err, &exceptionErr)
+		require.Equal(t, "items.*.name", exceptionErr.Path)
+		require.Equal(t, "items.b.name", exceptionErr.ExactPath)
+		require.Equal(t, "b", exceptionErr.Args["key"])
+	})
+
+	t.Run("nested", func(t *testing.T) {
+		err := validate.Slice("groups", [][]string{{"ok"}, {"ok", "fail"}}).Each(validate.SliceOf(func(items validate.SliceValidator[string]) error {
+			return items.Items("name", failing)
+		}))
+		require.ErrorIs(t, err, exception)
+
+		var exceptionErr validate.ExceptionError
+		require.ErrorAs(t, err, &exceptionErr)
+		require.Equal(t, "groups.*.*.name", exceptionErr.Path)
+		require.Equal(t, "groups.1.1.name", exceptionErr.ExactPath)
+		require.Equal(t, "exception for exact path: groups.1.1.name, path: groups.*.*.name, args: map[index:1]: some exception", exceptionErr.Error())
+	})
+}
+
+func TestErrorsQuery(t *testing.T) {
+	err := validate.Join(
+		validate.Field("email", "", validate.Required, validate.Email),
+		validate.Slice("items", []int{0, 5, 0}).Items("price", validate.MinNumber(1)),
+		validate.Field("internal.token", "", validate.Required),
+		validate.Field("internal", "", failValidatorWithCode[string]("internal")),
+	)
+	errs := validate.Errors(validate.Collect(err))
+	require.Equal(t, 5, errs.Len())
+
+	t.Run("by exact path", func(t *testing.T) {
+		email, ok := errs.ByExactPath("email")
+		require.True(t, ok)
+		require.True(t, email.HasCode(validate.CodeRequired))
+		require.True(t, email.HasCode(validate.CodeEmail))
+		require.False(t, email.HasCode(validate.CodeIBAN))
+
+		_, ok = errs.ByExactPath("name")
+		require.False(t, ok)
+	})
+
+	t.Run("by path", func(t *testing.T) {
+		prices := errs.ByPath("items.*.price")
+		require.Equal(t, 2, prices.Len())
+		require.Equal(t, "items.0.price", prices[0].ExactPath)
+		require.Equal(t, "items.2.price", prices[1].ExactPath)
+
+		require.Equal(t, 1, errs.ByPath("items.2.price").Len())
+		require.Equal(t, 0, errs.ByPath("items.*").Len())
+	})
+
+	t.Run("codes", func(t *testing.T) {
+		require.True(t, errs.HasCode(validate.CodeNumberMin))
+		require.False(t, errs.HasCode(validate.CodeIBAN))
+		require.Equal(t, []string{validate.CodeRequired, validate.CodeEmail, validate.CodeNumberMin, "internal"}, errs.Codes())
+	})
+
+	t.Run("filter and without", func(t *testing.T) {
+		required := errs.Filter(func(e validate.Error) bool { return e.HasCode(validate.CodeRequired) })
+		require.Equal(t, 2, required.Len())
+
+		public := errs.Without("internal.*")
+		require.Equal(t, 4, public.Len())
+		_, ok := public.ByExactPath("internal.token")
+		require.False(t, ok)
+		_, ok = public.ByExactPath("internal")
+		require.True(t, ok)
+
+		require.Equal(t, 3, errs.Without("internal").Len())
+		require.Equal(t, 5, errs.Len())
+	})
+}
+
+func TestViolationJSON(t *testing.T) {
+	b, err := json.Marshal(validate.Violation{Code: validate.CodeRequired, Cause: errors.New("secret"), Sensitive: true})
+	require.NoError(t, err)
+	require.Equal(t, `{"Code":"required","Args":null}`, string(b))
+
+	b, err = json.Marshal(validate.Violation{Code: validate.CodeRequired, Severity: validate.SeverityWarning, Message: "is required", Hint: "name"})
+	require.NoError(t, err)
+	require.Equal(t, `{"Code":"required","Args":null,"Severity":1,"Message":"is required","Hint":"name"}`, string(b))
+}
@@ -81,7 +81,29 @@ func ExampleMap() {
 	printError(err)
 
 	// Output:
-	// validation error for exact path: data.second.name, path: data.name, args: map[key:second], violations: [violation code: lowercase, args: map[]]
+	// validation error for exact path: data.second.name, path: data.*.name, args: map[key:second], violations: [violation code: lowercase, args: map[]]
+}
+
+func ExampleEnv() {
+	env := validate.EnvMap(map[string]string{
+		"BASE_URL": "example.org",
+		"PORT":     "70000",
+	})
+
+	_, portErr := validate.ParseVar(env, "PORT", validate.ParseInt[int], validate.MinNumber(1), validate.MaxNumber(65535))
+	_, timeoutErr := validate.ParseVar(env, "TIMEOUT", validate.ParseDuration)
+
+	err := validate.Join(
+		env.Var("BASE_URL", validate.URL),
+		portErr,
+		timeoutErr,
+	)
+	fmt.Print(validate.Report(err))
+
+	// Output:
+	// BASE_URL: url
+	// PORT: max.number (max=65535)
+	// TIMEOUT: required
 }
 
 func printError(err error) {
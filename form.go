@@ -0,0 +1,153 @@
+package validate
+
+import (
+	"maps"
+	"mime/multipart"
+	"net/url"
+	"slices"
+)
+
+// Form will validate the values of a classic HTML form.
+// Errors are reported at the form field names so they can be matched with the inputs of the form.
+func Form(values url.Values) FormValidator {
+	return FormValidator{
+		values: values,
+	}
+}
+
+type FormValidator struct {
+	values url.Values
+}
+
+// Value runs the validators on the first value of the field.
+// If the field is not present the validators receive an empty string so Required can be used.
+func (v FormValidator) Value(field string, validators ...Validator[string]) error {
+	return Field(field, v.values.Get(field), validators...)
+}
+
+// Values runs the validators on all values of a repeated field like tags[].
+// The slice is reported at the field path.
+func (v FormValidator) Values(field string, validators ...Validator[[]string]) error {
+	return Field(field, v.values[field], validators...)
+}
+
+// Repeated runs the validators on each value of a repeated field.
+// Errors are reported at the index of the value, e.g. tags[].1.
+func (v FormValidator) Repeated(field string, validators ...Validator[string]) error {
+	return Slice(field, v.values[field]).Each(validators...)
+}
+
+// AllowedKeys reports an unknown.field violation for every key in the form that is not in the allowed fields.
+func (v FormValidator) AllowedKeys(fields ...string) error {
+	return unknownKeys(fields, v.values)
+}
+
+// MultipartForm will validate a multipart form including the uploaded files.
+// A nil form is validated as an empty form, like r.MultipartForm before ParseMultipartForm is called.
+func MultipartForm(form *multipart.Form) MultipartValidator {
+	if form == nil {
+		form = &multipart.Form{}
+	}
+
+	return MultipartValidator{
+		FormValidator: Form(form.Value),
+		files:         form.File,
+	}
+}
+
+type MultipartValidator struct {
+	FormValidator
+	files map[string][]*multipart.FileHeader
+}
+
+// Files runs the validators on all uploaded files of the field.
+// This can be used to validate the amount of files with MinFiles and MaxFiles.
+func (v MultipartValidator) Files(field string, validators ...Validator[[]*multipart.FileHeader]) error {
+	return Field(field, v.files[field], validators...)
+}
+
+// File runs the validators on each uploaded file of the field.
+// Errors are reported at the index of the file, e.g. attachments.1.
+func (v MultipartValidator) File(field string, validators ...Validator[*multipart.FileHeader]) error {
+	return Slice(field, v.files[field]).Each(validators...)
+}
+
+// AllowedKeys reports an unknown.field violation for every value or file key that is not in the allowed fields.
+func (v MultipartValidator) AllowedKeys(fields ...string) error {
+	return Join(
+		unknownKeys(fields, v.values),
+		unknownKeys(fields, v.files),
+	)
+}
+
+// MinFiles validates that at least min files are uploaded.
+func MinFiles(min int) Validator[[]*multipart.FileHeader] {
+	return func(files []*multipart.FileHeader) error {
+		if len(files) < min {
+			return &Violation{Code: CodeFilesMin, Args: Args{"min": min}}
+		}
+
+		return nil
+	}
+}
+
+// MaxFiles validates that at most max files are uploaded.
+func MaxFiles(max int) Validator[[]*multipart.FileHeader] {
+	return func(files []*multipart.FileHeader) error {
+		if len(files) > max {
+			return &Violation{Code: CodeFilesMax, Args: Args{"max": max}}
+		}
+
+		return nil
+	}
+}
+
+// MaxFileSize validates the size of the uploaded file.
+func MaxFileSize(maxBytes int64) Validator[*multipart.FileHeader] {
+	return func(file *multipart.FileHeader) error {
+		if file.Size > maxBytes {
+			return &Violation{Code: CodeFileSize, Args: Args{"max_bytes": maxBytes}}
+		}
+
+		return nil
+	}
+}
+
+// DeclaredContentType validates the Content-Type header the client sent for the file.
+// The client controls this header, use SniffedContentType to inspect the actual content.
+func DeclaredContentType(accepted ...string) Validator[*multipart.FileHeader] {
+	mediaType := MediaType(accepted...)
+
+	return func(file *multipart.FileHeader) error {
+		return mediaType(file.Header.Get("Content-Type"))
+	}
+}
+
+// SniffedContentType validates the content type detected with http.DetectContentType.
+func SniffedContentType(accepted ...string) Validator[*multipart.FileHeader] {
+	return FileContent(MIMEType(accepted...))
+}
+
+// unknownKeys returns an unknown.field error for every key that is not allowed.
+// The keys are sorted so the order of the errors is stable.
+func unknownKeys[V any](allowed []string, values map[string]V) error {
+	var verrs Errors
+
+	for _, key := range slices.Sorted(maps.Keys(values)) {
+		if slices.Contains(allowed, key) {
+			continue
+		}
+
+		verrs = append(verrs, Error{
+			Path:       key,
+			ExactPath:  key,
+			Violations: []Violation{{Code: CodeUnknownField}},
+		})
+	}
+
+	if len(verrs) == 0 {
+		return nil
+	}
+
+	return verrs
+}
@@ -0,0 +1,118 @@
+package validate_test
+
+import (
+	"bytes"
+	"mime/multipart"
+	"net/textproto"
+	"net/url"
+	"testing"
+
+	"github.com/SLASH2NL/validate"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForm(t *testing.T) {
+	values := url.Values{
+		"email":  {"not-email"},
+		"tags[]": {"go", "", "validation"},
+		"extra":  {"1"},
+	}
+
+	t.Run("value", func(t *testing.T) {
+		err := validate.Form(values).Value("email", validate.Email)
+		errs := validate.Collect(err)
+		require.Equal(t, 1, len(errs))
+		require.Equal(t, "email", errs[0].ExactPath)
+		require.Equal(t, validate.CodeEmail, errs[0].Violations[0].Code)
+	})
+
+	t.Run("missing value", func(t *testing.T) {
+		err := validate.Form(values).Value("name", validate.Required)
+		errs := validate.Collect(err)
+		require.Equal(t, 1, len(errs))
+		require.Equal(t, "name", errs[0].ExactPath)
+		require.Equal(t, validate.CodeRequired, errs[0].Violations[0].Code)
+	})
+
+	t.Run("repeated", func(t *testing.T) {
+		err := validate.Form(values).Repeated("tags[]", validate.Required)
+		errs := validate.Collect(err)
+		require.Equal(t, 1, len(errs))
+		require.Equal(t, "tags[].*", errs[0].Path)
+		require.Equal(t, "tags[].1", errs[0].ExactPath)
+		require.Equal(t, 1, errs[0].Args["index"])
+	})
+
+	t.Run("allowed keys", func(t *testing.T) {
+		err := validate.Form(values).AllowedKeys("email", "tags[]")
+		errs := validate.Collect(err)
+		require.Equal(t, 1, len(errs))
+		require.Equal(t, "extra", errs[0].ExactPath)
+		require.Equal(t, validate.CodeUnknownField, errs[0].Violations[0].Code)
+
+		require.NoError(t, validate.Form(values).AllowedKeys("email", "tags[]", "extra"))
+	})
+}
+
+func TestMultipartForm(t *testing.T) {
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	require.NoError(t, w.WriteField("title", "Holiday"))
+
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Disposition", `form-data; name="attachments"; filename="a.png"`)
+	header.Set("Content-Type", "image/png")
+	part, err := w.CreatePart(header)
+	require.NoError(t, err)
+	_, err = part.Write([]byte("just some text"))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	form, err := multipart.NewReader(&body, w.Boundary()).ReadForm(1 << 20)
+	require.NoError(t, err)
+	defer form.RemoveAll()
+
+	v := validate.MultipartForm(form)
+
+	require.NoError(t, v.Value("title", validate.Required))
+	require.NoError(t, v.AllowedKeys("title", "attachments"))
+	require.NoError(t, v.File("attachments", validate.DeclaredContentType("image/png")))
+
+	err = v.Files("attachments", validate.MinFiles(2))
+	errs := validate.Collect(err)
+	require.Equal(t, 1, len(errs))
+	require.Equal(t, "attachments", errs[0].ExactPath)
+	require.Equal(t, validate.CodeFilesMin, errs[0].Violations[0].Code)
+
+	err = v.File("attachments", validate.MaxFileSize(4), validate.SniffedContentType("image/png"))
+	errs = validate.Collect(err)
+	require.Equal(t, 1, len(errs))
+	require.Equal(t, "attachments.*", errs[0].Path)
+	require.Equal(t, "attachments.0", errs[0].ExactPath)
+	require.Equal(t, 2, len(errs[0].Violations))
+	require.Equal(t, validate.CodeFileSize, errs[0].Violations[0].Code)
+	require.Equal(t, validate.CodeContentType, errs[0].Violations[1].Code)
+	require.Equal(t, "text/plain", errs[0].Violations[1].Args["detected"])
+
+	err = v.AllowedKeys("title")
+	errs = validate.Collect(err)
+	require.Equal(t, 1, len(errs))
+	require.Equal(t, "attachments", errs[0].ExactPath)
+}
+
+func TestMultipartFormNil(t *testing.T) {
+	v := validate.MultipartForm(nil)
+
+	require.NoError(t, v.AllowedKeys("title"))
+	require.NoError(t, v.File("attachments", validate.MaxFileSize(4)))
+
+	err := v.Value("title", validate.Required)
+	errs := validate.Collect(err)
+	require.Equal(t, 1, len(errs))
+	require.Equal(t, "title", errs[0].ExactPath)
+
+	err = v.Files("attachments", validate.MinFiles(1))
+	errs = validate.Collect(err)
+	require.Equal(t, 1, len(errs))
+	require.Equal(t, validate.CodeFilesMin, errs[0].Violations[0].Code)
+}
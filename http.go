@@ -0,0 +1,41 @@
+package validate
+
+import (
+	"mime"
+	"net/http"
+	"net/textproto"
+	"slices"
+)
+
+const (
+	// PathValuePrefix is the path prefix for errors in request path values.
+	PathValuePrefix = "path"
+	// HeaderPrefix is the path prefix for errors in request headers.
+	HeaderPrefix = "header"
+)
+
+// PathValue runs the validators on the path value of the request, see http.Request.PathValue.
+// Errors are reported at path.<name>, e.g. path.id.
+func PathValue(r *http.Request, name string, validators ...Validator[string]) error {
+	return Field(PathValuePrefix+"."+name, r.PathValue(name), validators...)
+}
+
+// Header runs the validators on the header value of the request.
+// Errors are reported at header.<name> with the canonical header name, e.g. header.Idempotency-Key.
+func Header(r *http.Request, name string, validators ...Validator[string]) error {
+	name = textproto.CanonicalMIMEHeaderKey(name)
+	return Field(HeaderPrefix+"."+name, r.Header.Get(name), validators...)
+}
+
+// MediaType validates that the value is a media type like a Content-Type header with one of the accepted types.
+// Parameters like charset are ignored.
+func MediaType(accepted ...string) Validator[string] {
+	return func(value string) error {
+		mediaType, _, err := mime.ParseMediaType(value)
+		if err != nil || !slices.Contains(accepted, mediaType) {
+			return &Violation{Code: CodeContentType, Args: Args{"accepted": accepted}}
+		}
+
+		return nil
+	}
+}
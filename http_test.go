@@ -0,0 +1,47 @@
+package validate_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/SLASH2NL/validate"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPathValue(t *testing.T) {
+	var err error
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /orders/{id}", func(w http.ResponseWriter, r *http.Request) {
+		err = validate.PathValue(r, "id", validate.MinString(5))
+	})
+	mux.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/orders/12", nil))
+
+	errs := validate.Collect(err)
+	require.Equal(t, 1, len(errs))
+	require.Equal(t, "path.id", errs[0].Path)
+	require.Equal(t, "path.id", errs[0].ExactPath)
+	require.Equal(t, validate.CodeStringMin, errs[0].Violations[0].Code)
+}
+
+func TestHeader(t *testing.T) {
+	r := httptest.NewRequest(http.MethodPost, "/orders", nil)
+	r.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	err := validate.Join(
+		validate.Header(r, "idempotency-key", validate.Required),
+		validate.Header(r, "Content-Type", validate.MediaType("application/json")),
+	)
+
+	errs := validate.Collect(err)
+	require.Equal(t, 1, len(errs))
+	require.Equal(t, "header.Idempotency-Key", errs[0].ExactPath)
+	require.Equal(t, validate.CodeRequired, errs[0].Violations[0].Code)
+
+	err = validate.Header(r, "Content-Type", validate.MediaType("application/xml"))
+	errs = validate.Collect(err)
+	require.Equal(t, 1, len(errs))
+	require.Equal(t, "header.Content-Type", errs[0].ExactPath)
+	require.Equal(t, validate.CodeContentType, errs[0].Violations[0].Code)
+}
@@ -7,7 +7,7 @@ import (
 func IBAN(value string) error {
 	_, err := iban.NewIBAN(string(value))
 	if err != nil {
-		return &Violation{Code: CodeIBAN}
+		return &Violation{Code: CodeIBAN, Message: "must be a valid IBAN", Cause: err}
 	}
 
 	return nil
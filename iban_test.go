@@ -12,6 +12,8 @@ func TestIBAN(t *testing.T) {
 		violation := validate.IBAN("invalid")
 		require.NotNil(t, violation)
 		require.Equal(t, validate.CodeIBAN, violation.(*validate.Violation).Code)
+		require.Equal(t, "must be a valid IBAN", violation.(*validate.Violation).Message)
+		require.Error(t, violation.(*validate.Violation).Cause)
 	})
 
 	t.Run("valid", func(t *testing.T) {
@@ -0,0 +1,140 @@
+package validate
+
+import (
+	"log/slog"
+	"maps"
+	"slices"
+	"strconv"
+)
+
+// DefaultMaxLogErrors is the number of errors that is logged by Errors.LogValue.
+const DefaultMaxLogErrors = 10
+
+// LogValue logs the number of errors and every error as a group keyed by its position.
+// At most DefaultMaxLogErrors errors are logged, the number of omitted errors is logged as omitted.
+// Use LogLimit to log a different number of errors.
+func (e Errors) LogValue() slog.Value {
+	return e.logValue(DefaultMaxLogErrors)
+}
+
+// LogLimit returns a slog.LogValuer that logs the errors like LogValue but logs at most max errors.
+// Zero or less means no limit.
+func (e Errors) LogLimit(max int) slog.LogValuer {
+	return limitedErrors{errs: e, max: max}
+}
+
+type limitedErrors struct {
+	errs Errors
+	max  int
+}
+
+func (l limitedErrors) LogValue() slog.Value {
+	return l.errs.logValue(l.max)
+}
+
+func (e Errors) logValue(max int) slog.Value {
+	attrs := []slog.Attr{slog.Int("count", len(e))}
+
+	for i, err := range e {
+		if max > 0 && i >= max {
+			attrs = append(attrs, slog.Int("omitted", len(e)-max))
+			break
+		}
+
+		attrs = append(attrs, slog.Any(strconv.Itoa(i), err))
+	}
+
+	return slog.GroupValue(attrs...)
+}
+
+// LogValue logs the paths, the violation codes and the args of the error and every violation as a group
+// keyed by its position. The args are masked according to the redaction policy.
+func (e Error) LogValue() slog.Value {
+	codes := make([]string, len(e.Violations))
+	violations := make([]any, len(e.Violations))
+	for i, violation := range e.Violations {
+		codes[i] = violation.Code
+		violations[i] = slog.Any(strconv.Itoa(i), violation)
+	}
+
+	attrs := []slog.Attr{
+		slog.String("path", e.Path),
+		slog.String("exact_path", redactPath(e.Path, e.ExactPath)),
+		slog.Any("codes", codes),
+	}
+
+	if args := e.Args.Redacted(false); len(args) > 0 {
+		attrs = append(attrs, argsAttr(args))
+	}
+
+	attrs = append(attrs, slog.Group("violations", violations...))
+
+	return slog.GroupValue(attrs...)
+}
+
+// LogValue logs the validation errors and every exception as a group keyed by its position.
+func (e AggregateError) LogValue() slog.Value {
+	exceptions := make([]any, len(e.Exceptions))
+	for i, exception := range e.Exceptions {
+		exceptions[i] = exceptionAttr(strconv.Itoa(i), exception)
+	}
+
+	return slog.GroupValue(
+		slog.Any("errors", e.Errors),
+		slog.Group("exceptions", exceptions...),
+	)
+}
+
+// LogValue logs the paths and the args of the exception and the message of the original exception.
+// The args are masked according to the redaction policy.
+func (e ExceptionError) LogValue() slog.Value {
+	attrs := []slog.Attr{
+		slog.String("path", e.Path),
+		slog.String("exact_path", redactPath(e.Path, e.ExactPath)),
+	}
+
+	if args := e.Args.Redacted(false); len(args) > 0 {
+		attrs = append(attrs, argsAttr(args))
+	}
+
+	if e.Err != nil {
+		attrs = append(attrs, slog.String("error", e.Err.Error()))
+	}
+
+	return slog.GroupValue(attrs...)
+}
+
+// exceptionAttr logs the exception with its LogValue if it has one and otherwise with its message.
+func exceptionAttr(key string, err error) slog.Attr {
+	if _, ok := err.(slog.LogValuer); ok {
+		return slog.Any(key, err)
+	}
+
+	return slog.String(key, err.Error())
+}
+
+// LogValue logs the code, the severity and the args of the violation.
+// The args are masked according to the redaction policy.
+func (v Violation) LogValue() slog.Value {
+	attrs := []slog.Attr{slog.String("code", v.Code)}
+
+	if v.Severity != SeverityError {
+		attrs = append(attrs, slog.String("severity", v.Severity.String()))
+	}
+
+	if args := v.RedactedArgs(); len(args) > 0 {
+		attrs = append(attrs, argsAttr(args))
+	}
+
+	return slog.GroupValue(attrs...)
+}
+
+// argsAttr returns the args as a group with the keys in sorted order.
+func argsAttr(args Args) slog.Attr {
+	var attrs []any
+	for _, key := range slices.Sorted(maps.Keys(args)) {
+		attrs = append(attrs, slog.Any(key, args[key]))
+	}
+
+	return slog.Group("args", attrs...)
+}
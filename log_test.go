@@ -0,0 +1,66 @@
+package validate_test
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"testing"
+
+	"github.com/SLASH2NL/validate"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if len(groups) == 0 && (a.Key == slog.TimeKey || a.Key == slog.LevelKey) {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+
+	t.Run("error", func(t *testing.T) {
+		buf.Reset()
+		err := validate.Slice("items", []string{"", "ok"}).Items("token", validate.Required, validate.Sensitive(validate.Equal("s3cr3t")))
+		logger.Info("invalid", "err", validate.Collect(err)[0])
+
+		require.Equal(t, "msg=invalid err.path=items.*.token err.exact_path=items.0.token err.codes=\"[required equal]\" err.args.index=0 err.violations.0.code=required err.violations.1.code=equal err.violations.1.args.expected=[REDACTED]\n", buf.String())
+	})
+
+	t.Run("violations with the same arg", func(t *testing.T) {
+		buf.Reset()
+		err := validate.Field("name", "a", validate.MinString(3), validate.MinString(5))
+		logger.Info("invalid", "err", err)
+
+		require.Equal(t, "msg=invalid err.path=name err.exact_path=name err.codes=\"[min.string min.string]\" err.violations.0.code=min.string err.violations.0.args.min=3 err.violations.1.code=min.string err.violations.1.args.min=5\n", buf.String())
+	})
+
+	t.Run("aggregate", func(t *testing.T) {
+		buf.Reset()
+		err := validate.JoinAll(
+			validate.Field("name", "", validate.Required),
+			validate.Slice("items", []string{"a"}).Each(func(string) error { return errors.New("database down") }),
+			errors.New("timeout"),
+		)
+		logger.Info("invalid", "err", err)
+
+		require.Equal(t, "msg=invalid err.errors.count=1 err.errors.0.path=name err.errors.0.exact_path=name err.errors.0.codes=[required] err.errors.0.violations.0.code=required err.exceptions.0.path=items.* err.exceptions.0.exact_path=items.0 err.exceptions.0.args.index=0 err.exceptions.0.error=\"database down\" err.exceptions.1=timeout\n", buf.String())
+	})
+
+	t.Run("errors with limit", func(t *testing.T) {
+		buf.Reset()
+		err := validate.Slice("items", []int{0, 0, 0}).Each(validate.MinNumber(1))
+		logger.Info("invalid", "errs", err.(validate.Errors).LogLimit(2))
+
+		require.Equal(t, "msg=invalid errs.count=3 errs.0.path=items.* errs.0.exact_path=items.0 errs.0.codes=[min.number] errs.0.args.index=0 errs.0.violations.0.code=min.number errs.0.violations.0.args.min=1 errs.1.path=items.* errs.1.exact_path=items.1 errs.1.codes=[min.number] errs.1.args.index=1 errs.1.violations.0.code=min.number errs.1.violations.0.args.min=1 errs.omitted=1\n", buf.String())
+	})
+
+	t.Run("violation", func(t *testing.T) {
+		buf.Reset()
+		logger.Info("invalid", "violation", validate.Violation{Code: "iban", Severity: validate.SeverityWarning, Args: validate.Args{"country": "NL"}})
+
+		require.Equal(t, "msg=invalid violation.code=iban violation.severity=warning violation.args.country=NL\n", buf.String())
+	})
+}
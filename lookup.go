@@ -0,0 +1,168 @@
+package validate
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"slices"
+	"strconv"
+	"sync"
+)
+
+// LookupFunc looks up the keys in a remote source like a database and returns which keys exist.
+// Keys missing in the returned map are treated as not existing.
+type LookupFunc[K comparable] func(ctx context.Context, keys []K) (map[K]bool, error)
+
+// Lookup batches existence checks of values that are collected while validating a Field, Slice or Map.
+// The values are registered with Field, LookupSlice or LookupMap next to the other validators, which
+// record the value with its path and return nil. Resolve runs the lookups in one batch and adds the
+// violations at the recorded exact paths.
+type Lookup[K comparable] struct {
+	fn        LookupFunc[K]
+	chunkSize int
+	exists    bool
+
+	mu      sync.Mutex
+	entries []lookupEntry[K]
+}
+
+// lookupEntry is a value recorded by the Lookup together with the path where it was found.
+type lookupEntry[K comparable] struct {
+	key       K
+	path      string
+	exactPath string
+	args      Args
+}
+
+// Exists returns a Lookup that reports a not.found violation for every value that does not exist.
+// The keys are passed to fn in chunks of at most chunkSize keys, zero means a single chunk.
+func Exists[K comparable](fn LookupFunc[K], chunkSize int) *Lookup[K] {
+	return &Lookup[K]{fn: fn, chunkSize: chunkSize, exists: true}
+}
+
+// NotExists returns a Lookup that reports a unique violation for every value that already exists.
+// The keys are passed to fn in chunks of at most chunkSize keys, zero means a single chunk.
+func NotExists[K comparable](fn LookupFunc[K], chunkSize int) *Lookup[K] {
+	return &Lookup[K]{fn: fn, chunkSize: chunkSize, exists: false}
+}
+
+// Field records the value of the field for the lookup. It always returns nil so it can be passed to
+// Join next to Field, the violation is reported by Resolve.
+func (l *Lookup[K]) Field(fieldName string, value K) error {
+	l.record(lookupEntry[K]{key: value, path: fieldName, exactPath: fieldName})
+	return nil
+}
+
+// LookupSlice records the key of every element of the slice for the lookup at <name>.<index>.<field>,
+// the same path that Items reports at. It always returns nil, the violations are reported by Resolve.
+func LookupSlice[T any, K comparable](l *Lookup[K], v SliceValidator[T], field string, key func(T) K) error {
+	for i, value := range v.value {
+		l.record(lookupEntry[K]{
+			key:       key(value),
+			path:      joinPath(v.name, "*", field),
+			exactPath: joinPath(v.name, strconv.Itoa(i), field),
+			args:      Args{"index": i},
+		})
+	}
+
+	return nil
+}
+
+// LookupMap records the key of every value of the map for the lookup at <name>.<key>.<field>,
+// the same path that Values reports at. It always returns nil, the violations are reported by Resolve.
+func LookupMap[MK comparable, V any, K comparable](l *Lookup[K], v MapValidator[MK, V], field string, key func(V) K) error {
+	for _, mapKey := range v.keys() {
+		l.record(lookupEntry[K]{
+			key:       key(v.value[mapKey]),
+			path:      joinPath(v.name, "*", field),
+			exactPath: joinPath(v.name, fmt.Sprintf("%v", mapKey), field),
+			args:      Args{"key": mapKey},
+		})
+	}
+
+	return nil
+}
+
+// Resolve looks up all recorded values and merges the violations into the validation errors of err.
+// Values are de-duplicated before they are passed to the lookup function and the recorded values are
+// cleared, so the Lookup can be reused. Exceptions in err and an error of the lookup function are kept next
+// to the validation errors of err in an AggregateError.
+func (l *Lookup[K]) Resolve(ctx context.Context, err error) error {
+	verrs, exceptions := splitErrors(err)
+
+	l.mu.Lock()
+	entries := l.entries
+	l.entries = nil
+	l.mu.Unlock()
+
+	var keys []K
+	seen := make(map[K]bool)
+	for _, entry := range entries {
+		if !seen[entry.key] {
+			seen[entry.key] = true
+			keys = append(keys, entry.key)
+		}
+	}
+
+	found := make(map[K]bool, len(keys))
+	for chunk := range l.chunks(keys) {
+		result, err := l.fn(ctx, chunk)
+		if err != nil {
+			return AggregateError{Errors: verrs, Exceptions: append(exceptions, err)}
+		}
+
+		for key, ok := range result {
+			found[key] = ok
+		}
+	}
+
+	for _, entry := range entries {
+		var violation Violation
+		switch {
+		case l.exists && !found[entry.key]:
+			violation = Violation{Code: CodeNotFound}
+		case !l.exists && found[entry.key]:
+			violation = Violation{Code: CodeUnique}
+		default:
+			continue
+		}
+
+		verrs = verrs.merge(Error{
+			Path:       entry.path,
+			ExactPath:  entry.exactPath,
+			Violations: []Violation{violation},
+			Args:       entry.args,
+		})
+	}
+
+	if len(exceptions) > 0 {
+		return AggregateError{Errors: verrs, Exceptions: exceptions}
+	}
+
+	if len(verrs) == 0 {
+		return nil
+	}
+
+	return verrs
+}
+
+// record adds the entry to the values that are looked up by Resolve.
+func (l *Lookup[K]) record(entry lookupEntry[K]) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.entries = append(l.entries, entry)
+}
+
+// chunks splits the keys in chunks of at most chunkSize keys.
+func (l *Lookup[K]) chunks(keys []K) iter.Seq[[]K] {
+	if len(keys) == 0 {
+		return func(yield func([]K) bool) {}
+	}
+
+	if l.chunkSize <= 0 {
+		return func(yield func([]K) bool) { yield(keys) }
+	}
+
+	return slices.Chunk(keys, l.chunkSize)
+}
@@ -0,0 +1,124 @@
+package validate_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/SLASH2NL/validate"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookupExists(t *testing.T) {
+	type line struct {
+		CustomerID string
+		Amount     int
+	}
+
+	lines := []line{
+		{CustomerID: "a", Amount: 1},
+		{CustomerID: "b", Amount: 1},
+		{CustomerID: "a", Amount: 0},
+		{CustomerID: "c", Amount: 1},
+		{CustomerID: "d", Amount: 1},
+	}
+
+	var calls [][]string
+	customers := validate.Exists(func(ctx context.Context, ids []string) (map[string]bool, error) {
+		calls = append(calls, ids)
+		return map[string]bool{"a": true, "c": true}, nil
+	}, 2)
+
+	err := validate.Join(
+		validate.LookupSlice(customers, validate.Slice("lines", lines), "customer_id", func(l line) string { return l.CustomerID }),
+		validate.Slice("lines", lines).Items("amount", validate.Resolve(func(l line) int { return l.Amount }, validate.MinNumber(1))...),
+	)
+	err = customers.Resolve(context.Background(), err)
+
+	require.Equal(t, [][]string{{"a", "b"}, {"c", "d"}}, calls)
+
+	errs := validate.Collect(err)
+	require.Equal(t, 3, len(errs))
+	require.Equal(t, "lines.2.amount", errs[0].ExactPath)
+	require.Equal(t, "lines.1.customer_id", errs[1].ExactPath)
+	require.Equal(t, "lines.*.customer_id", errs[1].Path)
+	require.Equal(t, validate.Args{"index": 1}, errs[1].Args)
+	require.Equal(t, []validate.Violation{{Code: validate.CodeNotFound}}, errs[1].Violations)
+	require.Equal(t, "lines.4.customer_id", errs[2].ExactPath)
+	require.Equal(t, validate.CodeNotFound, errs[2].Violations[0].Code)
+
+	// The recorded values are cleared by Resolve.
+	require.NoError(t, customers.Resolve(context.Background(), nil))
+	require.Len(t, calls, 2)
+}
+
+func TestLookupMap(t *testing.T) {
+	customers := validate.Exists(func(ctx context.Context, ids []string) (map[string]bool, error) {
+		return map[string]bool{"a": true}, nil
+	}, 0)
+
+	accounts := map[string]string{"first": "a", "second": "b"}
+	err := validate.LookupMap(customers, validate.Map("accounts", accounts), "customer_id", func(id string) string { return id })
+	require.NoError(t, err)
+
+	errs := validate.Collect(customers.Resolve(context.Background(), err))
+	require.Equal(t, 1, len(errs))
+	require.Equal(t, "accounts.*.customer_id", errs[0].Path)
+	require.Equal(t, "accounts.second.customer_id", errs[0].ExactPath)
+	require.Equal(t, validate.Args{"key": "second"}, errs[0].Args)
+}
+
+func TestLookupNotExists(t *testing.T) {
+	emails := validate.NotExists(func(ctx context.Context, emails []string) (map[string]bool, error) {
+		return map[string]bool{"taken@example.org": true}, nil
+	}, 0)
+
+	err := validate.Join(
+		validate.Field("email", "taken@example.org", validate.Email, validate.MaxString(10)),
+		emails.Field("email", "taken@example.org"),
+		emails.Field("backup_email", "free@example.org"),
+	)
+	err = emails.Resolve(context.Background(), err)
+
+	errs := validate.Collect(err)
+	require.Equal(t, 1, len(errs))
+	require.Equal(t, "email", errs[0].ExactPath)
+	require.Equal(t, validate.CodeStringMax, errs[0].Violations[0].Code)
+	require.Equal(t, validate.CodeUnique, errs[0].Violations[1].Code)
+
+	err = emails.Resolve(context.Background(), emails.Field("email", "free@example.org"))
+	require.NoError(t, err)
+}
+
+func TestLookupException(t *testing.T) {
+	exception := errors.New("database down")
+	customers := validate.Exists(func(ctx context.Context, ids []string) (map[string]bool, error) {
+		return nil, exception
+	}, 0)
+
+	err := customers.Resolve(context.Background(), customers.Field("customer_id", "a"))
+	require.ErrorIs(t, err, exception)
+	require.Equal(t, []error{exception}, validate.Exceptions(err))
+
+	// A failing lookup does not hide the other violations.
+	err = customers.Resolve(context.Background(), validate.Join(
+		validate.Field("name", "", validate.Required),
+		customers.Field("customer_id", "a"),
+	))
+	require.ErrorIs(t, err, exception)
+	errs := validate.Collect(err)
+	require.Equal(t, 1, len(errs))
+	require.Equal(t, "name", errs[0].ExactPath)
+
+	// Exceptions in the validated error are kept next to the lookup violations.
+	customers = validate.Exists(func(ctx context.Context, ids []string) (map[string]bool, error) {
+		return nil, nil
+	}, 0)
+
+	err = customers.Resolve(context.Background(), validate.JoinAll(
+		customers.Field("customer_id", "a"),
+		exception,
+	))
+	require.ErrorIs(t, err, exception)
+	require.Equal(t, "customer_id", validate.Collect(err)[0].ExactPath)
+}
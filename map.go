@@ -1,7 +1,12 @@
 package validate
 
 import (
+	"cmp"
 	"fmt"
+	"maps"
+	"reflect"
+	"regexp"
+	"slices"
 )
 
 func Map[K comparable, V any](name string, value map[K]V) MapValidator[K, V] {
@@ -12,8 +17,43 @@ func Map[K comparable, V any](name string, value map[K]V) MapValidator[K, V] {
 }
 
 type MapValidator[K comparable, V any] struct {
-	name  string
-	value map[K]V
+	name     string
+	value    map[K]V
+	compare  func(a, b K) int
+	nested   bool
+	warnings *Errors
+}
+
+// MapOf returns a validator for a nested map. The MapValidator passed to fn has no name so
+// its errors are relative to the element and get prefixed by the outer Slice or Map.
+func MapOf[K comparable, V any](fn func(MapValidator[K, V]) error) Validator[map[K]V] {
+	return func(value map[K]V) error {
+		return fn(MapValidator[K, V]{value: value, nested: true})
+	}
+}
+
+// SortKeys returns a MapValidator that uses the compare function to determine the order in which
+// Keys and Values report their errors. By default keys of an ordered type are sorted by value and
+// other keys are sorted by their formatted representation.
+func (v MapValidator[K, V]) SortKeys(compare func(a, b K) int) MapValidator[K, V] {
+	v.compare = compare
+	return v
+}
+
+// Warnings returns a MapValidator that moves the non blocking violations of Key, Keys, Values and Pattern to warnings.
+func (v MapValidator[K, V]) Warnings(warnings *Errors) MapValidator[K, V] {
+	v.warnings = warnings
+	return v
+}
+
+// blocking removes the non blocking violations from err. A nested MapValidator keeps them so they
+// are prefixed by the outer Slice or Map.
+func (v MapValidator[K, V]) blocking(err error) error {
+	if v.nested {
+		return err
+	}
+
+	return blocking(err, v.warnings)
 }
 
 // Key runs the validators on the value of the key.
@@ -22,78 +62,70 @@ func (v MapValidator[K, V]) Key(field string, key K, validators ...Validator[V])
 	value, ok := v.value[key]
 	if !ok {
 		return Error{
-			Path:       v.name + "." + field,
-			ExactPath:  v.name + "." + fmt.Sprintf("%v", key) + "." + field,
+			Path:       joinPath(v.name, "*", field),
+			ExactPath:  joinPath(v.name, fmt.Sprintf("%v", key), field),
 			Violations: []Violation{{Code: CodeUnknownField}},
 			Args:       Args{"key": key},
 		}
 	}
 
-	var verrs Errors
+	entry := func(yield func(K, V) bool) {
+		yield(key, value)
+	}
 
-	violations, err := validate(value, validators...)
-	if err != nil {
-		// It could be that the validators returned an Error or Errors. If so we map it with the correct paths.
-		if isValidationError(err) {
-			switch err := err.(type) {
-			case Error:
-				verrs = verrs.merge(prefixMapError(err, v.name, field, key))
-			case Errors:
-				verrs = verrs.mergeAll(err.mapErrors(func(err Error) Error {
-					return prefixMapError(err, v.name, field, key)
-				}))
+	return v.blocking(validateKeyed(v.name, field, entry, 0, validators...))
+}
+
+// Keys runs the validators on all keys.
+func (v MapValidator[K, V]) Keys(field string, validators ...Validator[K]) error {
+	keys := func(yield func(K, K) bool) {
+		for _, key := range v.keys() {
+			if !yield(key, key) {
+				return
 			}
-		} else {
-			return err
 		}
 	}
 
-	if len(violations) > 0 {
-		verrs = append(verrs, Error{
-			Path:       v.name + "." + field,
-			ExactPath:  v.name + "." + fmt.Sprintf("%v", key) + "." + field,
-			Violations: violations,
-			Args:       Args{"key": key},
-		})
+	return v.blocking(validateKeyed(v.name, field, keys, 0, validators...))
+}
+
+// Values runs the validators on all values.
+func (v MapValidator[K, V]) Values(field string, validators ...Validator[V]) error {
+	values := func(yield func(K, V) bool) {
+		for _, key := range v.keys() {
+			if !yield(key, v.value[key]) {
+				return
+			}
+		}
 	}
 
-	if len(verrs) == 0 {
-		return nil
+	return v.blocking(validateKeyed(v.name, field, values, 0, validators...))
+}
+
+// Pattern runs the validators on the values of all keys that match the regular expression.
+// Keys that are not strings are matched on their formatted representation.
+func (v MapValidator[K, V]) Pattern(re *regexp.Regexp, field string, validators ...Validator[V]) error {
+	matching := make(map[K]V)
+	for key, value := range v.value {
+		if re.MatchString(fmt.Sprintf("%v", key)) {
+			matching[key] = value
+		}
 	}
 
-	return verrs
+	v.value = matching
+	return v.Values(field, validators...)
 }
 
-// Keys runs the validators on all keys.
-func (v MapValidator[K, V]) Keys(field string, validators ...Validator[K]) error {
+// RequiredKeys reports a required violation for every key that is not present in the map.
+func (v MapValidator[K, V]) RequiredKeys(keys ...K) error {
 	var verrs Errors
 
-	for key := range v.value {
-		violations, err := validate(key, validators...)
-		if err != nil {
-			// It could be that the validators returned an Error or Errors. If so we map it with the correct paths.
-			if isValidationError(err) {
-				switch err := err.(type) {
-				case Error:
-					verrs = verrs.merge(prefixMapError(err, v.name, field, key))
-				case Errors:
-					verrs = verrs.mergeAll(err.mapErrors(func(err Error) Error {
-						return prefixMapError(err, v.name, field, key)
-					}))
-				}
-			} else {
-				return err
-			}
+	for _, key := range keys {
+		if _, ok := v.value[key]; ok {
+			continue
 		}
 
-		if len(violations) > 0 {
-			verrs = append(verrs, Error{
-				Path:       v.name + "." + field,
-				ExactPath:  v.name + "." + fmt.Sprintf("%v", key) + "." + field,
-				Violations: violations,
-				Args:       Args{"key": key},
-			})
-		}
+		verrs = append(verrs, v.keyError(key, CodeRequired))
 	}
 
 	if len(verrs) == 0 {
@@ -103,36 +135,16 @@ func (v MapValidator[K, V]) Keys(field string, validators ...Validator[K]) error
 	return verrs
 }
 
-// Values runs the validators on all values.
-func (v MapValidator[K, V]) Values(field string, validators ...Validator[V]) error {
+// AllowedKeys reports an unknown.field violation for every key in the map that is not one of the allowed keys.
+func (v MapValidator[K, V]) AllowedKeys(keys ...K) error {
 	var verrs Errors
 
-	for key, value := range v.value {
-		violations, err := validate(value, validators...)
-		if err != nil {
-			// It could be that the validators returned an Error or Errors. If so we map it with the correct paths.
-			if isValidationError(err) {
-				switch err := err.(type) {
-				case Error:
-					verrs = verrs.merge(prefixMapError(err, v.name, field, key))
-				case Errors:
-					verrs = verrs.mergeAll(err.mapErrors(func(err Error) Error {
-						return prefixMapError(err, v.name, field, key)
-					}))
-				}
-			} else {
-				return err
-			}
+	for _, key := range v.keys() {
+		if slices.Contains(keys, key) {
+			continue
 		}
 
-		if len(violations) > 0 {
-			verrs = append(verrs, Error{
-				Path:       v.name + "." + field,
-				ExactPath:  v.name + "." + fmt.Sprintf("%v", key) + "." + field,
-				Violations: violations,
-				Args:       Args{"key": key},
-			})
-		}
+		verrs = append(verrs, v.keyError(key, CodeUnknownField))
 	}
 
 	if len(verrs) == 0 {
@@ -142,9 +154,76 @@ func (v MapValidator[K, V]) Values(field string, validators ...Validator[V]) err
 	return verrs
 }
 
+// MinLen validates that the map contains at least min entries.
+func (v MapValidator[K, V]) MinLen(min int) error {
+	if len(v.value) < min {
+		return Error{
+			Path:       v.name,
+			ExactPath:  v.name,
+			Violations: []Violation{{Code: CodeLenMin, Args: Args{"min": min}}},
+		}
+	}
+
+	return nil
+}
+
+// MaxLen validates that the map contains at most max entries.
+func (v MapValidator[K, V]) MaxLen(max int) error {
+	if len(v.value) > max {
+		return Error{
+			Path:       v.name,
+			ExactPath:  v.name,
+			Violations: []Violation{{Code: CodeLenMax, Args: Args{"max": max}}},
+		}
+	}
+
+	return nil
+}
+
+// keyError returns an error with the given code for the key itself.
+func (v MapValidator[K, V]) keyError(key K, code string) Error {
+	return Error{
+		Path:       joinPath(v.name, "*"),
+		ExactPath:  joinPath(v.name, fmt.Sprintf("%v", key)),
+		Violations: []Violation{{Code: code}},
+		Args:       Args{"key": key},
+	}
+}
+
+// keys returns the keys of the map in a deterministic order.
+func (v MapValidator[K, V]) keys() []K {
+	compare := v.compare
+	if compare == nil {
+		compare = compareKeys[K]
+	}
+
+	return slices.SortedFunc(maps.Keys(v.value), compare)
+}
+
+// compareKeys compares ordered kinds by value and falls back to comparing the formatted keys.
+func compareKeys[K comparable](a, b K) int {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if va.Kind() != vb.Kind() {
+		return cmp.Compare(fmt.Sprintf("%v", a), fmt.Sprintf("%v", b))
+	}
+
+	switch va.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cmp.Compare(va.Int(), vb.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return cmp.Compare(va.Uint(), vb.Uint())
+	case reflect.Float32, reflect.Float64:
+		return cmp.Compare(va.Float(), vb.Float())
+	case reflect.String:
+		return cmp.Compare(va.String(), vb.String())
+	}
+
+	return cmp.Compare(fmt.Sprintf("%v", a), fmt.Sprintf("%v", b))
+}
+
 func prefixMapError(err Error, name string, field string, key any) Error {
-	err.Path = prefixPath(err.Path, name+"."+field)
-	err.ExactPath = prefixPath(err.ExactPath, name+"."+fmt.Sprintf("%v", key)+"."+field)
+	err.Path = joinPath(name, "*", field, err.Path)
+	err.ExactPath = joinPath(name, fmt.Sprintf("%v", key), field, err.ExactPath)
 	err.Args = err.Args.Add("key", key)
 	return err
 }
@@ -1,6 +1,8 @@
 package validate_test
 
 import (
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/SLASH2NL/validate"
@@ -17,7 +19,7 @@ func TestValidateMapKey(t *testing.T) {
 		require.Error(t, err)
 		errs := validate.Collect(err)
 		require.Equal(t, 1, len(errs))
-		require.Equal(t, "items.name", errs[0].Path)
+		require.Equal(t, "items.*.name", errs[0].Path)
 		require.Equal(t, "items.somekey.name", errs[0].ExactPath)
 		require.Equal(t, validate.CodeStringMin, errs[0].Violations[0].Code)
 		require.Equal(t, "somekey", errs[0].Args["key"])
@@ -32,7 +34,7 @@ func TestValidateMapKey(t *testing.T) {
 		require.Error(t, err)
 		errs := validate.Collect(err)
 		require.Equal(t, 1, len(errs))
-		require.Equal(t, "items.email", errs[0].Path)
+		require.Equal(t, "items.*.email", errs[0].Path)
 		require.Equal(t, "items.missingkey.email", errs[0].ExactPath)
 		require.Equal(t, validate.CodeUnknownField, errs[0].Violations[0].Code)
 		require.Equal(t, "missingkey", errs[0].Args["key"])
@@ -53,7 +55,7 @@ func TestValidateMapKey(t *testing.T) {
 		require.Error(t, err)
 		errs := validate.Collect(err)
 		require.Equal(t, 1, len(errs))
-		require.Equal(t, "items.name", errs[0].Path)
+		require.Equal(t, "items.*.name", errs[0].Path)
 		require.Equal(t, "items.somekey.name", errs[0].ExactPath)
 		require.Equal(t, validate.CodeStringMin, errs[0].Violations[0].Code)
 	})
@@ -79,7 +81,7 @@ func TestValidateMapKeys(t *testing.T) {
 		require.Error(t, err)
 		errs := validate.Collect(err)
 		require.Equal(t, 1, len(errs))
-		require.Equal(t, "items.name", errs[0].Path)
+		require.Equal(t, "items.*.name", errs[0].Path)
 		require.Equal(t, "items.somekey.name", errs[0].ExactPath)
 		require.Equal(t, validate.CodeStringMin, errs[0].Violations[0].Code)
 		require.Equal(t, "somekey", errs[0].Args["key"])
@@ -107,7 +109,7 @@ func TestValidateMapValues(t *testing.T) {
 		require.Error(t, err)
 		errs := validate.Collect(err)
 		require.Equal(t, 1, len(errs))
-		require.Equal(t, "items.name", errs[0].Path)
+		require.Equal(t, "items.*.name", errs[0].Path)
 		require.Equal(t, "items.other.name", errs[0].ExactPath)
 		require.Equal(t, validate.CodeEmail, errs[0].Violations[0].Code)
 		require.Equal(t, "other", errs[0].Args["key"])
@@ -131,7 +133,7 @@ func TestValidateMapValues(t *testing.T) {
 		require.Error(t, err)
 		errs := validate.Collect(err)
 		require.Equal(t, 1, len(errs))
-		require.Equal(t, "items.email", errs[0].Path)
+		require.Equal(t, "items.*.email", errs[0].Path)
 		require.Equal(t, "items.other.email", errs[0].ExactPath)
 		require.Equal(t, validate.CodeEmail, errs[0].Violations[0].Code)
 		require.Equal(t, "other", errs[0].Args["key"])
@@ -147,3 +149,82 @@ func TestValidateMapValues(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestValidateMapOrder(t *testing.T) {
+	t.Run("ordered keys", func(t *testing.T) {
+		data := map[int]string{10: "", 2: "", 1: "", 30: ""}
+
+		for range 10 {
+			errs := validate.Collect(validate.Map("items", data).Values("name", validate.Required))
+			require.Equal(t, 4, len(errs))
+			require.Equal(t, "items.1.name", errs[0].ExactPath)
+			require.Equal(t, "items.2.name", errs[1].ExactPath)
+			require.Equal(t, "items.10.name", errs[2].ExactPath)
+			require.Equal(t, "items.30.name", errs[3].ExactPath)
+		}
+	})
+
+	t.Run("comparator", func(t *testing.T) {
+		data := map[string]int{"a": 1, "b": 2, "c": 3}
+
+		err := validate.Map("items", data).
+			SortKeys(func(a, b string) int { return strings.Compare(b, a) }).
+			Keys("name", validate.MinString(2))
+		errs := validate.Collect(err)
+		require.Equal(t, 3, len(errs))
+		require.Equal(t, "items.c.name", errs[0].ExactPath)
+		require.Equal(t, "items.b.name", errs[1].ExactPath)
+		require.Equal(t, "items.a.name", errs[2].ExactPath)
+	})
+}
+
+func TestValidateMapStructure(t *testing.T) {
+	data := map[string]string{
+		"name":    "John",
+		"x-trace": "",
+		"x-user":  "john",
+		"other":   "",
+	}
+
+	t.Run("required keys", func(t *testing.T) {
+		err := validate.Map("headers", data).RequiredKeys("name", "email", "phone")
+		errs := validate.Collect(err)
+		require.Equal(t, 2, len(errs))
+		require.Equal(t, "headers.email", errs[0].ExactPath)
+		require.Equal(t, validate.CodeRequired, errs[0].Violations[0].Code)
+		require.Equal(t, "headers.phone", errs[1].ExactPath)
+	})
+
+	t.Run("allowed keys", func(t *testing.T) {
+		err := validate.Map("headers", data).AllowedKeys("name", "x-trace")
+		errs := validate.Collect(err)
+		require.Equal(t, 2, len(errs))
+		require.Equal(t, "headers.other", errs[0].ExactPath)
+		require.Equal(t, validate.CodeUnknownField, errs[0].Violations[0].Code)
+		require.Equal(t, "other", errs[0].Args["key"])
+		require.Equal(t, "headers.x-user", errs[1].ExactPath)
+	})
+
+	t.Run("length", func(t *testing.T) {
+		require.NoError(t, validate.Map("headers", data).MinLen(1))
+		require.NoError(t, validate.Map("headers", data).MaxLen(4))
+
+		errs := validate.Collect(validate.Map("headers", data).MinLen(5))
+		require.Equal(t, 1, len(errs))
+		require.Equal(t, "headers", errs[0].ExactPath)
+		require.Equal(t, validate.CodeLenMin, errs[0].Violations[0].Code)
+
+		errs = validate.Collect(validate.Map("headers", data).MaxLen(3))
+		require.Equal(t, 1, len(errs))
+		require.Equal(t, validate.CodeLenMax, errs[0].Violations[0].Code)
+		require.Equal(t, 3, errs[0].Violations[0].Args["max"])
+	})
+
+	t.Run("pattern", func(t *testing.T) {
+		err := validate.Map("headers", data).Pattern(regexp.MustCompile(`^x-`), "value", validate.Required)
+		errs := validate.Collect(err)
+		require.Equal(t, 1, len(errs))
+		require.Equal(t, "headers.*.value", errs[0].Path)
+		require.Equal(t, "headers.x-trace.value", errs[0].ExactPath)
+	})
+}
@@ -1,13 +1,15 @@
 package validate
 
 import (
+	"fmt"
+
 	"golang.org/x/exp/constraints"
 )
 
 func MinNumber[T constraints.Integer | constraints.Float](min T) Validator[T] {
 	return func(value T) error {
 		if value < min {
-			return &Violation{Code: CodeNumberMin, Args: Args{"min": min}}
+			return &Violation{Code: CodeNumberMin, Args: Args{"min": min}, Message: fmt.Sprintf("must be at least %v", min)}
 		}
 
 		return nil
@@ -17,7 +19,7 @@ func MinNumber[T constraints.Integer | constraints.Float](min T) Validator[T] {
 func MaxNumber[T constraints.Integer | constraints.Float](max T) Validator[T] {
 	return func(value T) error {
 		if value > max {
-			return &Violation{Code: CodeNumberMax, Args: Args{"max": max}}
+			return &Violation{Code: CodeNumberMax, Args: Args{"max": max}, Message: fmt.Sprintf("must be at most %v", max)}
 		}
 
 		return nil
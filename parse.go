@@ -0,0 +1,145 @@
+package validate
+
+import (
+	"encoding"
+	"reflect"
+	"strconv"
+	"time"
+
+	"golang.org/x/exp/constraints"
+)
+
+// Parser converts a raw string into a typed value.
+// If the string can not be converted it should return a Violation. Any other error is treated as an exception.
+type Parser[T any] func(value string) (T, error)
+
+// Parse will parse the raw value with the parser and run the validators on the parsed value.
+// If parsing fails the violation of the parser is returned at the field path and the validators are not run.
+// The parsed value is returned together with the error so it can be used by the caller.
+func Parse[T any](fieldName string, value string, parser Parser[T], validators ...Validator[T]) (T, error) {
+	parsed, err := parser(value)
+	if err != nil {
+		var zero T
+
+		violations, err := validate(value, func(string) error { return err })
+		if err != nil {
+			return zero, err
+		}
+
+		return zero, Error{
+			Path:       fieldName,
+			ExactPath:  fieldName,
+			Violations: violations,
+		}
+	}
+
+	return parsed, Field(fieldName, parsed, validators...)
+}
+
+// ParseInt parses a signed integer with the bit size of T.
+func ParseInt[T constraints.Signed](value string) (T, error) {
+	n, err := strconv.ParseInt(value, 10, reflect.TypeFor[T]().Bits())
+	if err != nil {
+		return 0, typeViolation[T](err)
+	}
+
+	return T(n), nil
+}
+
+// ParseUint parses an unsigned integer with the bit size of T.
+func ParseUint[T constraints.Unsigned](value string) (T, error) {
+	n, err := strconv.ParseUint(value, 10, reflect.TypeFor[T]().Bits())
+	if err != nil {
+		return 0, typeViolation[T](err)
+	}
+
+	return T(n), nil
+}
+
+// ParseFloat parses a floating point number with the bit size of T.
+func ParseFloat[T constraints.Float](value string) (T, error) {
+	n, err := strconv.ParseFloat(value, reflect.TypeFor[T]().Bits())
+	if err != nil {
+		return 0, typeViolation[T](err)
+	}
+
+	return T(n), nil
+}
+
+// ParseBool parses a boolean using strconv.ParseBool.
+func ParseBool(value string) (bool, error) {
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, typeViolation[bool](err)
+	}
+
+	return b, nil
+}
+
+// ParseTime returns a parser that parses a time with the given layout.
+func ParseTime(layout string) Parser[time.Time] {
+	return func(value string) (time.Time, error) {
+		t, err := time.Parse(layout, value)
+		if err != nil {
+			return time.Time{}, &Violation{
+				Code:    CodeFormat,
+				Args:    Args{"layout": layout},
+				Message: "must be a time in the format " + layout,
+				Hint:    layout,
+				Cause:   err,
+			}
+		}
+
+		return t, nil
+	}
+}
+
+// ParseDuration parses a duration using time.ParseDuration.
+func ParseDuration(value string) (time.Duration, error) {
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, &Violation{
+			Code:    CodeFormat,
+			Args:    Args{"type": "duration"},
+			Message: "must be a valid duration",
+			Hint:    "duration",
+			Cause:   err,
+		}
+	}
+
+	return d, nil
+}
+
+// ParseText parses the value using the encoding.TextUnmarshaler implementation of *T.
+func ParseText[T any, PT interface {
+	*T
+	encoding.TextUnmarshaler
+}](value string) (T, error) {
+	var t T
+	if err := PT(&t).UnmarshalText([]byte(value)); err != nil {
+		var zero T
+		typ := reflect.TypeFor[T]().String()
+
+		return zero, &Violation{
+			Code:    CodeFormat,
+			Args:    Args{"type": typ},
+			Message: "must be a valid " + typ,
+			Hint:    typ,
+			Cause:   err,
+		}
+	}
+
+	return t, nil
+}
+
+func typeViolation[T any](cause error) *Violation {
+	typ := reflect.TypeFor[T]().String()
+
+	return &Violation{
+		Code:    CodeType,
+		Args:    Args{"type": typ},
+		Message: "must be a valid " + typ,
+		Hint:    typ,
+		Cause:   cause,
+	}
+}
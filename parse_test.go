@@ -0,0 +1,84 @@
+package validate_test
+
+import (
+	"errors"
+	"net/netip"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/SLASH2NL/validate"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		n, err := validate.Parse("limit", "10", validate.ParseInt[int], validate.MaxNumber(20))
+		require.NoError(t, err)
+		require.Equal(t, 10, n)
+	})
+
+	t.Run("invalid type", func(t *testing.T) {
+		n, err := validate.Parse("limit", "ten", validate.ParseInt[int], validate.MaxNumber(20))
+		require.Error(t, err)
+		require.Equal(t, 0, n)
+
+		errs := validate.Collect(err)
+		require.Equal(t, 1, len(errs))
+		require.Equal(t, "limit", errs[0].ExactPath)
+		require.Equal(t, validate.CodeType, errs[0].Violations[0].Code)
+		require.Equal(t, "int", errs[0].Violations[0].Args["type"])
+		require.Equal(t, "must be a valid int", errs[0].Violations[0].Message)
+		require.ErrorIs(t, errs[0].Violations[0], strconv.ErrSyntax)
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+		_, err := validate.Parse("limit", "300", validate.ParseUint[uint8])
+		errs := validate.Collect(err)
+		require.Equal(t, 1, len(errs))
+		require.Equal(t, validate.CodeType, errs[0].Violations[0].Code)
+	})
+
+	t.Run("validators run on parsed value", func(t *testing.T) {
+		n, err := validate.Parse("price", "30.5", validate.ParseFloat[float64], validate.MaxNumber(20.0))
+		require.Error(t, err)
+		require.Equal(t, 30.5, n)
+
+		errs := validate.Collect(err)
+		require.Equal(t, 1, len(errs))
+		require.Equal(t, validate.CodeNumberMax, errs[0].Violations[0].Code)
+	})
+
+	t.Run("time", func(t *testing.T) {
+		_, err := validate.Parse("from", "2024-13-01", validate.ParseTime(time.DateOnly))
+		errs := validate.Collect(err)
+		require.Equal(t, 1, len(errs))
+		require.Equal(t, validate.CodeFormat, errs[0].Violations[0].Code)
+		require.Equal(t, time.DateOnly, errs[0].Violations[0].Args["layout"])
+		require.Equal(t, time.DateOnly, errs[0].Violations[0].Hint)
+
+		var parseErr *time.ParseError
+		require.ErrorAs(t, errs[0].Violations[0].Cause, &parseErr)
+
+		d, err := validate.Parse("from", "2024-12-01", validate.ParseTime(time.DateOnly))
+		require.NoError(t, err)
+		require.Equal(t, 2024, d.Year())
+	})
+
+	t.Run("text unmarshaler", func(t *testing.T) {
+		addr, err := validate.Parse("ip", "127.0.0.1", validate.ParseText[netip.Addr])
+		require.NoError(t, err)
+		require.True(t, addr.IsLoopback())
+
+		_, err = validate.Parse("ip", "localhost", validate.ParseText[netip.Addr])
+		errs := validate.Collect(err)
+		require.Equal(t, 1, len(errs))
+		require.Equal(t, validate.CodeFormat, errs[0].Violations[0].Code)
+	})
+
+	t.Run("parser exception", func(t *testing.T) {
+		exception := errors.New("some exception")
+		_, err := validate.Parse("id", "1", func(string) (int, error) { return 0, exception })
+		require.Equal(t, exception, err)
+	})
+}
@@ -0,0 +1,165 @@
+package validate
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"sync/atomic"
+)
+
+// RedactionPolicy determines which args are masked when errors are rendered by Error, slog or JSON.
+type RedactionPolicy struct {
+	// Mask replaces the redacted values, defaults to DefaultMask.
+	Mask string
+	// Keys are arg keys that are always redacted, for example "expected" to never render compared values.
+	// If "key" or "index" is redacted the map keys and slice indexes in the exact path are masked as well.
+	Keys []string
+}
+
+// DefaultMask is the mask that is used if the policy has no mask.
+const DefaultMask = "[REDACTED]"
+
+var redaction atomic.Pointer[RedactionPolicy]
+
+// SetRedactionPolicy sets the package level redaction policy.
+func SetRedactionPolicy(policy RedactionPolicy) {
+	redaction.Store(&policy)
+}
+
+// Sensitive runs the validators and marks their violations as sensitive.
+// All args of a sensitive violation are masked when it is rendered.
+func Sensitive[T any](validators ...Validator[T]) Validator[T] {
+	return func(value T) error {
+		violations, err := validate(value, validators...)
+		if err != nil {
+			return err
+		}
+
+		if violations == nil {
+			return nil
+		}
+
+		for i := range violations {
+			violations[i].Sensitive = true
+		}
+
+		return Violations(violations)
+	}
+}
+
+// SensitiveField runs the validators on the value like Field and marks the violations as sensitive.
+func SensitiveField[T any](fieldName string, value T, validators ...Validator[T]) error {
+	return Field(fieldName, value, Sensitive(validators...))
+}
+
+// Redacted returns a copy of the args where the values are masked according to the redaction policy.
+// If all is true every value is masked.
+func (e Args) Redacted(all bool) Args {
+	if e == nil {
+		return nil
+	}
+
+	policy := redaction.Load()
+	if !all && (policy == nil || len(policy.Keys) == 0) {
+		return e
+	}
+
+	mask := policy.mask()
+	redacted := make(Args, len(e))
+	for key, value := range e {
+		if all || slices.Contains(policy.Keys, key) {
+			value = mask
+		}
+		redacted[key] = value
+	}
+
+	return redacted
+}
+
+// mask returns the mask of the policy or DefaultMask if there is none.
+func (p *RedactionPolicy) mask() string {
+	if p == nil || p.Mask == "" {
+		return DefaultMask
+	}
+
+	return p.Mask
+}
+
+// redactPath masks the segments of the exact path at the wildcard positions of the path if the redaction
+// policy redacts the key or index arg, so a map key like an email address is not rendered as part of the path.
+// Every wildcard position is masked, this includes the keys and indexes of nested Slices and Maps.
+func redactPath(path string, exactPath string) string {
+	policy := redaction.Load()
+	if policy == nil || (!slices.Contains(policy.Keys, "key") && !slices.Contains(policy.Keys, "index")) {
+		return exactPath
+	}
+
+	masked, ok := maskWildcards(strings.Split(path, "."), exactPath, policy.mask())
+	if !ok {
+		return exactPath
+	}
+
+	return masked
+}
+
+// maskWildcards matches the exact path against the segments of the path and replaces the parts at the wildcard
+// positions with the mask. A map key may contain dots, the shortest key for which the rest of the path matches is used.
+func maskWildcards(pattern []string, exactPath string, mask string) (string, bool) {
+	segment, last := pattern[0], len(pattern) == 1
+
+	if segment != "*" {
+		if last {
+			return segment, exactPath == segment
+		}
+
+		rest, ok := strings.CutPrefix(exactPath, segment+".")
+		if !ok {
+			return "", false
+		}
+
+		masked, ok := maskWildcards(pattern[1:], rest, mask)
+		return segment + "." + masked, ok
+	}
+
+	if last {
+		return mask, exactPath != ""
+	}
+
+	for i := 1; i < len(exactPath); i++ {
+		if exactPath[i] != '.' {
+			continue
+		}
+
+		if masked, ok := maskWildcards(pattern[1:], exactPath[i+1:], mask); ok {
+			return mask + "." + masked, true
+		}
+	}
+
+	return "", false
+}
+
+// RedactedArgs returns the args of the violation masked according to the redaction policy.
+func (v Violation) RedactedArgs() Args {
+	return v.Args.Redacted(v.Sensitive)
+}
+
+// MarshalJSON renders the violation with its args masked according to the redaction policy.
+func (v Violation) MarshalJSON() ([]byte, error) {
+	type violation Violation
+
+	r := violation(v)
+	r.Args = v.RedactedArgs()
+
+	return json.Marshal(r)
+}
+
+// MarshalJSON renders the error with its args masked according to the redaction policy.
+func (e Error) MarshalJSON() ([]byte, error) {
+	type validationError Error
+
+	r := validationError(e)
+	r.ExactPath = redactPath(e.Path, e.ExactPath)
+	r.Args = e.Args.Redacted(false)
+
+	return json.Marshal(r)
+}
@@ -0,0 +1,88 @@
+package validate_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/SLASH2NL/validate"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSensitive(t *testing.T) {
+	err := validate.Join(
+		validate.SensitiveField("token", "guess", validate.Equal("s3cr3t")),
+		validate.Field("role", "root", validate.Sensitive(validate.OneOf("admin", "user")), validate.MaxString(3)),
+	)
+	errs := validate.Collect(err)
+	require.Equal(t, 2, len(errs))
+
+	// The args are still available for the caller.
+	require.Equal(t, "s3cr3t", errs[0].Violations[0].Args["expected"])
+	require.True(t, errs[0].Violations[0].Sensitive)
+
+	require.Equal(t, "validation error for exact path: token, path: token, args: map[], violations: [violation code: equal, args: map[expected:[REDACTED]]]", errs[0].Error())
+	require.Equal(t, "token: equal (expected=[REDACTED])\nrole: oneof (accepted=[REDACTED])\nrole: max.string (max=3)\n", validate.Report(err))
+
+	b, jerr := json.Marshal(errs[0])
+	require.NoError(t, jerr)
+	require.NotContains(t, string(b), "s3cr3t")
+	require.Contains(t, string(b), `"Args":{"expected":"[REDACTED]"}`)
+}
+
+func TestRedactionPolicy(t *testing.T) {
+	validate.SetRedactionPolicy(validate.RedactionPolicy{Mask: "***", Keys: []string{"not", "key"}})
+	defer validate.SetRedactionPolicy(validate.RedactionPolicy{})
+
+	err := validate.Field("password", "hunter2", validate.Not("hunter2"), validate.MinString(10))
+	require.Equal(t, "validation error for exact path: password, path: password, args: map[], violations: [violation code: not, args: map[not:***] violation code: min.string, args: map[min:10]]", err.Error())
+
+	err = validate.Map("users", map[string]string{"jane@example.org": ""}).Values("name", validate.Required)
+	errs := validate.Collect(err)
+	require.Equal(t, "jane@example.org", errs[0].Args["key"])
+	require.Equal(t, validate.Args{"key": "***"}, errs[0].Args.Redacted(false))
+
+	require.Equal(t, "users.***.name: required (key=***)\n", validate.Report(err))
+
+	b, jerr := json.Marshal(err)
+	require.NoError(t, jerr)
+
+	var logs bytes.Buffer
+	slog.New(slog.NewJSONHandler(&logs, nil)).Info("invalid", "errors", err)
+
+	// The key is not rendered anywhere, only the error itself still contains it.
+	for _, output := range []string{err.Error(), validate.Report(err), string(b), logs.String()} {
+		require.NotContains(t, output, "jane@example.org")
+		require.Contains(t, output, "users.***.name")
+	}
+	require.Equal(t, "users.jane@example.org.name", errs[0].ExactPath)
+
+	validate.SetRedactionPolicy(validate.RedactionPolicy{Keys: []string{"index"}})
+	err = validate.Slice("items", []string{"a", ""}).Each(validate.Required)
+	require.Equal(t, "items.[REDACTED]: required (index=[REDACTED])\n", validate.Report(err))
+}
+
+func TestRedactionPolicyPaths(t *testing.T) {
+	validate.SetRedactionPolicy(validate.RedactionPolicy{Keys: []string{"key"}})
+	defer validate.SetRedactionPolicy(validate.RedactionPolicy{})
+
+	t.Run("allowed keys", func(t *testing.T) {
+		err := validate.Map("users", map[string]string{"jane@example.org": ""}).AllowedKeys()
+		require.NotContains(t, err.Error(), "jane@example.org")
+		require.Equal(t, "users.[REDACTED]: unknown.field (key=[REDACTED])\n", validate.Report(err))
+	})
+
+	t.Run("nested maps", func(t *testing.T) {
+		orgs := map[string]map[string]string{"acme": {"jane@x.org": ""}}
+		err := validate.Map("orgs", orgs).Values("", validate.MapOf(func(v validate.MapValidator[string, string]) error {
+			return v.Values("name", validate.Required)
+		}))
+		require.Equal(t, "orgs.[REDACTED].[REDACTED].name: required (key=[REDACTED])\n", validate.Report(err))
+	})
+
+	t.Run("key equals the name", func(t *testing.T) {
+		err := validate.Map("users", map[string]string{"users": ""}).Values("name", validate.Required)
+		require.Equal(t, "users.[REDACTED].name: required (key=[REDACTED])\n", validate.Report(err))
+	})
+}
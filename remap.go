@@ -0,0 +1,166 @@
+package validate
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Remap returns a Remapper that rewrites the paths of errors according to the mapping table.
+// The keys are matched against the start of the path and may contain * segments that match any segment.
+// The * segments in the value are replaced with the matched segments in order, so Lines.*.Qty mapped to
+// items.*.quantity rewrites Lines.3.Qty to items.3.quantity and Lines.*.Qty to items.*.quantity.
+// Segments after the matched prefix are kept. If multiple keys match, the longest key is used.
+func Remap(mapping map[string]string) Remapper {
+	var rules []remapRule
+	for _, from := range slices.Sorted(maps.Keys(mapping)) {
+		rules = append(rules, remapRule{
+			from: strings.Split(from, "."),
+			to:   strings.Split(mapping[from], "."),
+		})
+	}
+
+	slices.SortStableFunc(rules, func(a, b remapRule) int {
+		return cmp.Compare(len(b.from), len(a.from))
+	})
+
+	return Remapper{rules: rules}
+}
+
+// RemapFunc returns a Remapper that rewrites the paths of errors with fn.
+// The fn is called for both the path and the exact path and returns false if the path is not mapped.
+func RemapFunc(fn func(path string) (string, bool)) Remapper {
+	return Remapper{fn: fn}
+}
+
+// Remapper rewrites the Path and ExactPath of errors, for example from Go field names to API field names.
+type Remapper struct {
+	rules  []remapRule
+	fn     func(path string) (string, bool)
+	strict bool
+}
+
+type remapRule struct {
+	from []string
+	to   []string
+}
+
+// Strict returns a Remapper that reports paths without a mapping in an UnmappedPathsError.
+func (r Remapper) Strict() Remapper {
+	r.strict = true
+	return r
+}
+
+// Apply rewrites the paths of all validation errors and ExceptionErrors in err. Errors that end up with the
+// same exact path are merged. Other exceptions are kept. In strict mode an UnmappedPathsError is added as
+// exception if one or more paths could not be mapped. If err contains no validation errors or ExceptionErrors
+// it is returned as is.
+func (r Remapper) Apply(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	errs, exceptions := splitErrors(err)
+	if len(errs) == 0 && !slices.ContainsFunc(exceptions, isExceptionError) {
+		return err
+	}
+
+	var verrs Errors
+	var unmapped []string
+	for _, e := range errs {
+		path, pathOK := r.rewrite(e.Path)
+		exactPath, exactOK := r.rewrite(e.ExactPath)
+		if !pathOK || !exactOK {
+			unmapped = append(unmapped, e.ExactPath)
+		}
+
+		e.Path = path
+		e.ExactPath = exactPath
+		verrs = verrs.merge(e)
+	}
+
+	for i, exception := range exceptions {
+		e, ok := exception.(ExceptionError)
+		if !ok {
+			continue
+		}
+
+		path, pathOK := r.rewrite(e.Path)
+		exactPath, exactOK := r.rewrite(e.ExactPath)
+		if !pathOK || !exactOK {
+			unmapped = append(unmapped, e.ExactPath)
+		}
+
+		e.Path = path
+		e.ExactPath = exactPath
+		exceptions[i] = e
+	}
+
+	if r.strict && len(unmapped) > 0 {
+		exceptions = append(exceptions, UnmappedPathsError{Paths: unmapped})
+	}
+
+	if len(exceptions) > 0 {
+		return AggregateError{Errors: verrs, Exceptions: exceptions}
+	}
+
+	if len(verrs) == 0 {
+		return nil
+	}
+
+	return verrs
+}
+
+// rewrite returns the mapped path and whether a mapping was found.
+func (r Remapper) rewrite(path string) (string, bool) {
+	if r.fn != nil {
+		mapped, ok := r.fn(path)
+		if !ok {
+			return path, false
+		}
+
+		return mapped, true
+	}
+
+	segments := strings.Split(path, ".")
+	for _, rule := range r.rules {
+		if !matchSegments(rule.from, segments, true) {
+			continue
+		}
+
+		var captured []string
+		for i, segment := range rule.from {
+			if segment == "*" {
+				captured = append(captured, segments[i])
+			}
+		}
+
+		var mapped []string
+		for _, segment := range rule.to {
+			if segment == "*" && len(captured) > 0 {
+				segment, captured = captured[0], captured[1:]
+			}
+			mapped = append(mapped, segment)
+		}
+
+		return joinPath(append(mapped, segments[len(rule.from):]...)...), true
+	}
+
+	return path, false
+}
+
+// UnmappedPathsError is returned by a strict Remapper for the exact paths that have no mapping.
+type UnmappedPathsError struct {
+	Paths []string
+}
+
+func (e UnmappedPathsError) Error() string {
+	return fmt.Sprintf("unmapped paths: %v", e.Paths)
+}
+
+func isExceptionError(err error) bool {
+	_, ok := err.(ExceptionError)
+	return ok
+}
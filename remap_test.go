@@ -0,0 +1,96 @@
+package validate_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/SLASH2NL/validate"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRemap(t *testing.T) {
+	err := validate.Join(
+		validate.Field("CustomerID", "", validate.Required),
+		validate.Slice("Lines", []int{1, 0}).Items("Qty", validate.MinNumber(1)),
+		validate.Slice("Lines", []string{"", "b"}).Items("SKU", validate.Required),
+		validate.Field("Internal", "", validate.Required),
+	)
+
+	remapper := validate.Remap(map[string]string{
+		"CustomerID":  "customer_id",
+		"Lines":       "items",
+		"Lines.*.Qty": "items.*.quantity",
+	})
+
+	t.Run("mapping", func(t *testing.T) {
+		errs := validate.Collect(remapper.Apply(err))
+		require.Equal(t, 4, len(errs))
+		require.Equal(t, "customer_id", errs[0].Path)
+		require.Equal(t, "customer_id", errs[0].ExactPath)
+		require.Equal(t, "items.*.quantity", errs[1].Path)
+		require.Equal(t, "items.1.quantity", errs[1].ExactPath)
+		require.Equal(t, "items.*.SKU", errs[2].Path)
+		require.Equal(t, "items.0.SKU", errs[2].ExactPath)
+		require.Equal(t, "Internal", errs[3].ExactPath)
+	})
+
+	t.Run("strict", func(t *testing.T) {
+		remapped := remapper.Strict().Apply(err)
+
+		var unmapped validate.UnmappedPathsError
+		require.ErrorAs(t, remapped, &unmapped)
+		require.Equal(t, []string{"Internal"}, unmapped.Paths)
+		require.Equal(t, 4, len(validate.Collect(remapped)))
+	})
+
+	t.Run("func", func(t *testing.T) {
+		remapped := validate.RemapFunc(func(path string) (string, bool) {
+			return strings.ToLower(path), true
+		}).Strict().Apply(err)
+
+		errs := validate.Collect(remapped)
+		require.Equal(t, 4, len(errs))
+		require.Equal(t, "customerid", errs[0].ExactPath)
+		require.Equal(t, "lines.*.qty", errs[1].Path)
+		require.Empty(t, validate.Exceptions(remapped))
+	})
+
+	t.Run("exceptions", func(t *testing.T) {
+		exception := errors.New("some exception")
+		err := validate.JoinAll(
+			validate.Field("CustomerID", "", validate.Required),
+			validate.Slice("Lines", []int{1}).Items("Qty", func(int) error { return exception }),
+			validate.Slice("Internal", []int{1}).Each(func(int) error { return exception }),
+		)
+
+		remapped := remapper.Apply(err)
+		require.ErrorIs(t, remapped, exception)
+		require.Equal(t, "customer_id", validate.Collect(remapped)[0].ExactPath)
+
+		exceptions := validate.Exceptions(remapped)
+		require.Equal(t, 2, len(exceptions))
+		require.Equal(t, "items.*.quantity", exceptions[0].(validate.ExceptionError).Path)
+		require.Equal(t, "items.0.quantity", exceptions[0].(validate.ExceptionError).ExactPath)
+		require.Equal(t, "Internal.0", exceptions[1].(validate.ExceptionError).ExactPath)
+
+		var unmapped validate.UnmappedPathsError
+		require.ErrorAs(t, remapper.Strict().Apply(err), &unmapped)
+		require.Equal(t, []string{"Internal.0"}, unmapped.Paths)
+
+		// A single exception is remapped as well.
+		remapped = remapper.Apply(validate.Slice("Lines", []int{1}).Each(func(int) error { return exception }))
+		require.Equal(t, "items.0", validate.Exceptions(remapped)[0].(validate.ExceptionError).ExactPath)
+	})
+
+	t.Run("merges paths", func(t *testing.T) {
+		err := validate.Join(
+			validate.Field("customerId", "", validate.Required),
+			validate.Field("customer_id", "", validate.Email),
+		)
+
+		errs := validate.Collect(validate.Remap(map[string]string{"customerId": "customer_id"}).Apply(err))
+		require.Equal(t, 1, len(errs))
+		require.Equal(t, 2, len(errs[0].Violations))
+	})
+}
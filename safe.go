@@ -0,0 +1,75 @@
+package validate
+
+import (
+	"fmt"
+	"runtime/debug"
+	"sync"
+)
+
+// PanicError is the exception returned when a validator that is wrapped by Safe panics.
+// When the validator is run by Field, Slice, Map, Seq or CSV it is wrapped in an ExceptionError
+// with the exact path of the value.
+type PanicError struct {
+	Value any
+	Stack []byte
+
+	// report calls the hook of Safe once with the ExceptionError that contains the exact path.
+	report func(ExceptionError)
+}
+
+func (e PanicError) Error() string {
+	return fmt.Sprintf("validator panicked: %v", e.Value)
+}
+
+// Unwrap returns the panic value if it is an error.
+func (e PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// Safe wraps the validators so they recover from panics and return them as PanicError.
+// The PanicError is reported at the path of the wrapped validator, so Slice("persons", p).Each(Safe(nil, v)...)
+// reports it at persons.<index>. The hook may be nil, it is called once with the ExceptionError when the
+// outermost Field, Join, Slice, Map, Seq or CSV returns the panic, so the exact path is complete.
+func Safe[T any](hook func(ExceptionError), validators ...Validator[T]) []Validator[T] {
+	wrapped := make([]Validator[T], len(validators))
+	for i, validator := range validators {
+		wrapped[i] = func(value T) (err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					panicErr := PanicError{Value: r, Stack: debug.Stack()}
+					if hook != nil {
+						var once sync.Once
+						panicErr.report = func(e ExceptionError) {
+							once.Do(func() { hook(e) })
+						}
+					}
+
+					err = panicErr
+				}
+			}()
+
+			return validator(value)
+		}
+	}
+
+	return wrapped
+}
+
+// reportPanics calls the hook of Safe for every recovered panic in err that is annotated with its path.
+func reportPanics(err error) {
+	if err == nil || isValidationError(err) {
+		return
+	}
+
+	for _, exception := range Exceptions(err) {
+		e, ok := exception.(ExceptionError)
+		if !ok {
+			continue
+		}
+
+		if panicErr, ok := e.Err.(PanicError); ok && panicErr.report != nil {
+			panicErr.report(e)
+		}
+	}
+}
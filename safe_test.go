@@ -0,0 +1,97 @@
+package validate_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/SLASH2NL/validate"
+	"github.com/stretchr/testify/require"
+)
+
+type testAddress struct {
+	Street string
+}
+
+type testPerson struct {
+	Address *testAddress
+}
+
+func TestSafe(t *testing.T) {
+	street := func(p testPerson) string { return p.Address.Street }
+	persons := []testPerson{
+		{Address: &testAddress{Street: "Street 1"}},
+		{},
+	}
+
+	t.Run("not wrapped", func(t *testing.T) {
+		require.Panics(t, func() {
+			_ = validate.Slice("persons", persons).Each(validate.ResolveField("street", street, validate.Required))
+		})
+	})
+
+	t.Run("wrapped", func(t *testing.T) {
+		var recovered []validate.ExceptionError
+		hook := func(err validate.ExceptionError) {
+			recovered = append(recovered, err)
+		}
+
+		err := validate.Slice("persons", persons).Each(validate.Safe(hook, validate.ResolveField("street", street, validate.Required))...)
+		require.Error(t, err)
+		require.False(t, validate.IsValidationError(err))
+
+		var exceptionErr validate.ExceptionError
+		require.ErrorAs(t, err, &exceptionErr)
+		require.Equal(t, "persons.*", exceptionErr.Path)
+		require.Equal(t, "persons.1", exceptionErr.ExactPath)
+
+		var panicErr validate.PanicError
+		require.ErrorAs(t, err, &panicErr)
+		require.NotEmpty(t, panicErr.Stack)
+
+		var runtimeErr interface{ RuntimeError() }
+		require.True(t, errors.As(err, &runtimeErr))
+
+		require.Equal(t, 1, len(recovered))
+		require.Equal(t, "persons.1", recovered[0].ExactPath)
+		require.ErrorAs(t, recovered[0], &panicErr)
+	})
+
+	t.Run("field", func(t *testing.T) {
+		err := validate.Field("name", "", validate.Safe(nil, func(string) error { panic("boom") })...)
+
+		var exceptionErr validate.ExceptionError
+		require.ErrorAs(t, err, &exceptionErr)
+		require.Equal(t, "name", exceptionErr.ExactPath)
+		require.Equal(t, "validator panicked: boom", exceptionErr.Err.Error())
+	})
+
+	t.Run("nested slice", func(t *testing.T) {
+		var recovered []validate.ExceptionError
+		hook := func(err validate.ExceptionError) {
+			recovered = append(recovered, err)
+		}
+
+		panics := func(string) error { panic("boom") }
+		lines := validate.SliceOf(func(v validate.SliceValidator[string]) error {
+			return v.Each(validate.Safe(hook, panics)...)
+		})
+
+		err := validate.Join(validate.Slice("orders", [][]string{{"a"}}).Items("lines", lines))
+		require.Error(t, err)
+		require.Equal(t, 1, len(recovered))
+		require.Equal(t, "orders.*.lines.*", recovered[0].Path)
+		require.Equal(t, "orders.0.lines.0", recovered[0].ExactPath)
+	})
+
+	t.Run("resolved field", func(t *testing.T) {
+		person := testPerson{Address: &testAddress{}}
+		panics := func(string) error { panic("boom") }
+
+		err := validate.Slice("persons", []testPerson{person}).Each(validate.ResolveField("street", street, validate.Safe(nil, panics)...))
+
+		var exceptionErr validate.ExceptionError
+		require.ErrorAs(t, err, &exceptionErr)
+		require.Equal(t, "persons.*.street", exceptionErr.Path)
+		require.Equal(t, "persons.0.street", exceptionErr.ExactPath)
+	})
+}
@@ -0,0 +1,189 @@
+package validate
+
+import (
+	"fmt"
+	"iter"
+	"strconv"
+)
+
+// Seq will run the validators on each value produced by the iterator.
+// The errors have the same paths as Slice so the values are reported by their position in the sequence.
+func Seq[F ~string, T any](name F, seq iter.Seq[T]) SeqValidator[T] {
+	return SeqValidator[T]{
+		name: string(name),
+		seq:  seq,
+	}
+}
+
+type SeqValidator[T any] struct {
+	name      string
+	seq       iter.Seq[T]
+	maxErrors int
+	warnings  *Errors
+}
+
+// MaxErrors returns a SeqValidator that stops consuming the sequence once max errors are collected.
+// Zero means no limit.
+func (v SeqValidator[T]) MaxErrors(max int) SeqValidator[T] {
+	v.maxErrors = max
+	return v
+}
+
+// Warnings returns a SeqValidator that moves the non blocking violations of Items and Each to warnings.
+func (v SeqValidator[T]) Warnings(warnings *Errors) SeqValidator[T] {
+	v.warnings = warnings
+	return v
+}
+
+// Items runs the validators on each value and reports the errors at <name>.<index>.<field>.
+// The error is nil if there are only non blocking violations, use Warnings to retrieve them.
+func (v SeqValidator[T]) Items(field string, validators ...Validator[T]) error {
+	return blocking(validateIndexed(v.name, field, enumerate(v.seq), v.maxErrors, validators...), v.warnings)
+}
+
+// Each runs the validators on each value and reports the errors directly at <name>.<index>.
+func (v SeqValidator[T]) Each(validators ...Validator[T]) error {
+	return v.Items("", validators...)
+}
+
+// Seq2 will run the validators on each key value pair produced by the iterator.
+// The errors have the same paths as Map so the pairs are reported by their key.
+func Seq2[F ~string, K any, V any](name F, seq iter.Seq2[K, V]) Seq2Validator[K, V] {
+	return Seq2Validator[K, V]{
+		name: string(name),
+		seq:  seq,
+	}
+}
+
+type Seq2Validator[K any, V any] struct {
+	name      string
+	seq       iter.Seq2[K, V]
+	maxErrors int
+	warnings  *Errors
+}
+
+// MaxErrors returns a Seq2Validator that stops consuming the sequence once max errors are collected.
+// Zero means no limit.
+func (v Seq2Validator[K, V]) MaxErrors(max int) Seq2Validator[K, V] {
+	v.maxErrors = max
+	return v
+}
+
+// Warnings returns a Seq2Validator that moves the non blocking violations of Keys and Values to warnings.
+func (v Seq2Validator[K, V]) Warnings(warnings *Errors) Seq2Validator[K, V] {
+	v.warnings = warnings
+	return v
+}
+
+// Keys runs the validators on all keys.
+func (v Seq2Validator[K, V]) Keys(field string, validators ...Validator[K]) error {
+	keys := func(yield func(K, K) bool) {
+		for key := range v.seq {
+			if !yield(key, key) {
+				return
+			}
+		}
+	}
+
+	return blocking(validateKeyed(v.name, field, keys, v.maxErrors, validators...), v.warnings)
+}
+
+// Values runs the validators on all values.
+func (v Seq2Validator[K, V]) Values(field string, validators ...Validator[V]) error {
+	return blocking(validateKeyed(v.name, field, v.seq, v.maxErrors, validators...), v.warnings)
+}
+
+// validateIndexed runs the validators on every value and reports the errors at <name>.<index>.<field>.
+// It stops once maxErrors errors are collected, zero means no limit.
+func validateIndexed[T any](name string, field string, seq iter.Seq2[int, T], maxErrors int, validators ...Validator[T]) error {
+	var verrs Errors
+
+	for i, value := range seq {
+		path := joinPath(name, "*", field)
+		exactPath := joinPath(name, strconv.Itoa(i), field)
+
+		violations, errs, exceptions := validateNested(value, validators...)
+		errs = errs.mapErrors(func(err Error) Error {
+			return prefixSliceError(err, name, field, i)
+		})
+
+		if len(violations) > 0 {
+			errs = errs.merge(Error{
+				Path:       path,
+				ExactPath:  exactPath,
+				Violations: violations,
+				Args:       Args{"index": i},
+			})
+		}
+
+		if len(exceptions) > 0 {
+			return nestedException(verrs.mergeAll(errs), exceptions, path, exactPath, Args{"index": i})
+		}
+
+		verrs = verrs.mergeAll(errs)
+
+		if maxErrors > 0 && len(verrs) >= maxErrors {
+			break
+		}
+	}
+
+	if len(verrs) == 0 {
+		return nil
+	}
+
+	return verrs
+}
+
+// validateKeyed runs the validators on every value and reports the errors at <name>.<key>.<field>.
+// It stops once maxErrors errors are collected, zero means no limit.
+func validateKeyed[K any, V any](name string, field string, seq iter.Seq2[K, V], maxErrors int, validators ...Validator[V]) error {
+	var verrs Errors
+
+	for key, value := range seq {
+		path := joinPath(name, "*", field)
+		exactPath := joinPath(name, fmt.Sprintf("%v", key), field)
+
+		violations, errs, exceptions := validateNested(value, validators...)
+		errs = errs.mapErrors(func(err Error) Error {
+			return prefixMapError(err, name, field, key)
+		})
+
+		if len(violations) > 0 {
+			errs = errs.merge(Error{
+				Path:       path,
+				ExactPath:  exactPath,
+				Violations: violations,
+				Args:       Args{"key": key},
+			})
+		}
+
+		if len(exceptions) > 0 {
+			return nestedException(verrs.mergeAll(errs), exceptions, path, exactPath, Args{"key": key})
+		}
+
+		verrs = verrs.mergeAll(errs)
+
+		if maxErrors > 0 && len(verrs) >= maxErrors {
+			break
+		}
+	}
+
+	if len(verrs) == 0 {
+		return nil
+	}
+
+	return verrs
+}
+
+// enumerate returns a sequence of the values together with their position.
+func enumerate[T any](seq iter.Seq[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for value := range seq {
+			if !yield(i, value) {
+				return
+			}
+			i++
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package validate_test
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/SLASH2NL/validate"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeq(t *testing.T) {
+	data := []testSlice{
+		{Name: "John Deer", Amount: 9},
+		{Name: "Deer John", Amount: 1},
+	}
+
+	err := validate.Seq("data", slices.Values(data)).Items("amount", validate.Resolve(func(v testSlice) int { return v.Amount }, validate.MinNumber(5))...)
+	errs := validate.Collect(err)
+	require.Equal(t, 1, len(errs))
+	require.Equal(t, "data.*.amount", errs[0].Path)
+	require.Equal(t, "data.1.amount", errs[0].ExactPath)
+	require.Equal(t, 1, errs[0].Args["index"])
+
+	err = validate.Seq("emails", slices.Values([]string{"invalid", "test@example.org"})).Each(validate.Email)
+	errs = validate.Collect(err)
+	require.Equal(t, 1, len(errs))
+	require.Equal(t, "emails.0", errs[0].ExactPath)
+}
+
+func TestSeqMaxErrors(t *testing.T) {
+	consumed := 0
+	seq := func(yield func(int) bool) {
+		for i := range 1000 {
+			consumed++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+
+	err := validate.Seq("numbers", seq).MaxErrors(3).Each(validate.MinNumber(5000))
+	errs := validate.Collect(err)
+	require.Equal(t, 3, len(errs))
+	require.Equal(t, "numbers.2", errs[2].ExactPath)
+	require.Equal(t, 3, consumed)
+}
+
+func TestSeq2(t *testing.T) {
+	data := map[string]string{
+		"first":  "test@example.org",
+		"second": "invalid",
+	}
+
+	err := validate.Seq2("emails", maps.All(data)).Values("email", validate.Email)
+	errs := validate.Collect(err)
+	require.Equal(t, 1, len(errs))
+	require.Equal(t, "emails.*.email", errs[0].Path)
+	require.Equal(t, "emails.second.email", errs[0].ExactPath)
+	require.Equal(t, "second", errs[0].Args["key"])
+
+	err = validate.Seq2("emails", maps.All(data)).Keys("key", validate.MinString(6))
+	errs = validate.Collect(err)
+	require.Equal(t, 1, len(errs))
+	require.Equal(t, "emails.first.key", errs[0].ExactPath)
+
+	err = validate.Seq2("numbers", slices.All([]int{1, 2, 3})).MaxErrors(1).Values("", validate.MinNumber(5))
+	errs = validate.Collect(err)
+	require.Equal(t, 1, len(errs))
+	require.Equal(t, "numbers.0", errs[0].ExactPath)
+}
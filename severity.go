@@ -0,0 +1,162 @@
+package validate
+
+import "slices"
+
+// Severity determines whether a violation blocks the request.
+// The zero value is SeverityError so existing violations are blocking.
+type Severity int
+
+const (
+	// SeverityError is a blocking violation.
+	SeverityError Severity = iota
+	// SeverityWarning is a violation that does not block but should be surfaced.
+	SeverityWarning
+	// SeverityInfo is an informational violation.
+	SeverityInfo
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityWarning:
+		return "warning"
+	case SeverityInfo:
+		return "info"
+	default:
+		return "error"
+	}
+}
+
+// Warn runs the validators and turns their violations into warnings.
+func Warn[T any](validators ...Validator[T]) Validator[T] {
+	return WithSeverity(SeverityWarning, validators...)
+}
+
+// Info runs the validators and turns their violations into informational violations.
+func Info[T any](validators ...Validator[T]) Validator[T] {
+	return WithSeverity(SeverityInfo, validators...)
+}
+
+// WithSeverity runs the validators and sets the severity on their violations.
+// The severity is also set on the Error and Errors returned by nested validators like ResolveField and SliceOf.
+func WithSeverity[T any](severity Severity, validators ...Validator[T]) Validator[T] {
+	return func(value T) error {
+		violations, errs, exceptions := validateNested(value, validators...)
+
+		for i := range violations {
+			violations[i].Severity = severity
+		}
+
+		errs = errs.mapErrors(func(e Error) Error {
+			e.Violations = slices.Clone(e.Violations)
+			for i := range e.Violations {
+				e.Violations[i].Severity = severity
+			}
+
+			return e
+		})
+
+		if len(exceptions) > 0 {
+			if len(errs) == 0 && len(exceptions) == 1 {
+				return exceptions[0]
+			}
+
+			return AggregateError{Errors: errs, Exceptions: exceptions}
+		}
+
+		if len(errs) == 0 {
+			if violations == nil {
+				return nil
+			}
+
+			return Violations(violations)
+		}
+
+		// The violations of the value itself are reported at the path of the value.
+		if len(violations) > 0 {
+			errs = errs.merge(Error{Violations: violations})
+		}
+
+		return errs
+	}
+}
+
+// FieldResult runs the validators on the value like Field but the returned error is only non nil for
+// blocking violations and exceptions. Warnings and informational violations are returned as Errors.
+func FieldResult[T any](fieldName string, value T, validators ...Validator[T]) (Errors, error) {
+	return SplitSeverity(field(fieldName, value, validators...))
+}
+
+// FieldWarnings runs the validators on the value like Field and moves the warnings and informational violations
+// to warnings. Together with the Warnings option of Slice, Map and Seq it collects the non blocking violations
+// of multiple fields that are joined with Join.
+func FieldWarnings[T any](warnings *Errors, fieldName string, value T, validators ...Validator[T]) error {
+	return blocking(field(fieldName, value, validators...), warnings)
+}
+
+// blocking returns nil if err only contains non blocking violations. If warnings is not nil the non blocking
+// violations are moved to warnings, otherwise they are kept in err so they are rendered next to the
+// blocking violations. Recovered panics in err are reported to the hook of Safe.
+func blocking(err error, warnings *Errors) error {
+	reportPanics(err)
+
+	if err == nil || !containsValidationError(err) {
+		return err
+	}
+
+	nonBlocking, blockingErr := SplitSeverity(err)
+	if warnings != nil {
+		*warnings = warnings.mergeAll(nonBlocking)
+		return blockingErr
+	}
+
+	if blockingErr == nil {
+		return nil
+	}
+
+	return err
+}
+
+// SplitSeverity separates the blocking violations in err from the warnings and informational violations.
+// The returned error is only non nil if err contains a blocking violation or an exception, the
+// non blocking violations are returned as Errors so they can still be rendered.
+func SplitSeverity(err error) (Errors, error) {
+	if err == nil || !containsValidationError(err) {
+		return nil, err
+	}
+
+	errs, exceptions := splitErrors(err)
+
+	var blocking, nonBlocking Errors
+	for _, e := range errs {
+		var blockingViolations, nonBlockingViolations []Violation
+		for _, violation := range e.Violations {
+			if violation.Severity == SeverityError {
+				blockingViolations = append(blockingViolations, violation)
+			} else {
+				nonBlockingViolations = append(nonBlockingViolations, violation)
+			}
+		}
+
+		if len(blockingViolations) > 0 {
+			b := e
+			b.Violations = blockingViolations
+			blocking = append(blocking, b)
+		}
+
+		if len(nonBlockingViolations) > 0 {
+			n := e
+			n.Violations = nonBlockingViolations
+			nonBlocking = append(nonBlocking, n)
+		}
+	}
+
+	if len(exceptions) > 0 {
+		return nonBlocking, AggregateError{Errors: blocking, Exceptions: exceptions}
+	}
+
+	if len(blocking) == 0 {
+		return nonBlocking, nil
+	}
+
+	return nonBlocking, blocking
+}
@@ -0,0 +1,81 @@
+package validate_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/SLASH2NL/validate"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeverity(t *testing.T) {
+	t.Run("only warnings", func(t *testing.T) {
+		err := validate.Field("iban", "", validate.Warn[string](validate.Required))
+		require.NoError(t, err)
+		require.False(t, validate.IsValidationError(err))
+
+		var warnings validate.Errors
+		err = validate.Join(
+			validate.FieldWarnings(&warnings, "iban", "NL91ABNA0417164300", validate.IBAN, validate.Warn(validate.Prefix("DE"))),
+			validate.FieldWarnings(&warnings, "name", "John", validate.Info(validate.MinString(10))),
+		)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(warnings))
+		require.Equal(t, "iban", warnings[0].ExactPath)
+		require.Equal(t, validate.SeverityWarning, warnings[0].Violations[0].Severity)
+		require.Equal(t, validate.SeverityInfo, warnings[1].Violations[0].Severity)
+
+		require.Equal(t, "iban: prefix (prefix=DE) [warning]\nname: min.string (min=10) [info]\n", validate.Report(warnings))
+		require.Equal(t, "violation code: prefix, severity: warning, args: map[prefix:DE]", warnings[0].Violations[0].Error())
+	})
+
+	t.Run("slice", func(t *testing.T) {
+		type item struct {
+			Name string
+			Tags []string
+		}
+		items := []item{{Name: "", Tags: []string{"a", ""}}}
+
+		err := validate.Slice("items", items).Each(validate.Warn(validate.ResolveField("name", func(i item) string { return i.Name }, validate.Required)))
+		require.NoError(t, err)
+
+		var warnings validate.Errors
+		err = validate.Join(
+			validate.Slice("items", items).Warnings(&warnings).Each(
+				validate.Warn(validate.ResolveField("name", func(i item) string { return i.Name }, validate.Required)),
+			),
+			validate.Slice("items", items).Warnings(&warnings).Items("tags", validate.Warn(validate.Resolve(func(i item) []string { return i.Tags }, validate.SliceOf(func(v validate.SliceValidator[string]) error {
+				return v.Each(validate.Required)
+			}))...)),
+		)
+		require.NoError(t, err)
+		require.Equal(t, 2, len(warnings))
+		require.Equal(t, "items.0.name", warnings[0].ExactPath)
+		require.Equal(t, validate.SeverityWarning, warnings[0].Violations[0].Severity)
+		require.Equal(t, "items.0.tags.1", warnings[1].ExactPath)
+		require.Equal(t, validate.SeverityWarning, warnings[1].Violations[0].Severity)
+	})
+
+	t.Run("blocking and warnings", func(t *testing.T) {
+		warnings, err := validate.FieldResult("iban", "invalid", validate.IBAN, validate.Warn(validate.Prefix("DE")))
+		errs := validate.Collect(err)
+		require.Equal(t, 1, len(errs))
+		require.Equal(t, 1, len(errs[0].Violations))
+		require.Equal(t, validate.CodeIBAN, errs[0].Violations[0].Code)
+		require.Equal(t, 1, len(warnings))
+		require.Equal(t, validate.CodePrefix, warnings[0].Violations[0].Code)
+
+		// Without FieldResult the warnings are kept next to the blocking violation.
+		err = validate.Field("iban", "invalid", validate.IBAN, validate.Warn(validate.Prefix("DE")))
+		require.Equal(t, "iban: iban\niban: prefix (prefix=DE) [warning]\n", validate.Report(err))
+	})
+
+	t.Run("exceptions are blocking", func(t *testing.T) {
+		exception := errors.New("some exception")
+		err := validate.FieldAll("iban", "invalid", validate.Warn(validate.IBAN), func(string) error { return exception })
+
+		warnings, blocking := validate.SplitSeverity(err)
+		require.ErrorIs(t, blocking, exception)
+		require.Equal(t, 1, len(warnings))
+	})
+}
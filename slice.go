@@ -1,6 +1,10 @@
 package validate
 
-import "fmt"
+import (
+	"context"
+	"slices"
+	"strconv"
+)
 
 // Slice will run the validators on each element in the slice.
 func Slice[F ~string, T any](name F, value []T) SliceValidator[T] {
@@ -11,39 +15,147 @@ func Slice[F ~string, T any](name F, value []T) SliceValidator[T] {
 }
 
 type SliceValidator[T any] struct {
-	name  string
-	value []T
+	name     string
+	value    []T
+	ctx      context.Context
+	workers  int
+	nested   bool
+	warnings *Errors
 }
 
+// SliceOf returns a validator for a nested slice. The SliceValidator passed to fn has no name so
+// its errors are relative to the element and get prefixed by the outer Slice or Map. This allows
+// validating a [][]Cell with paths like grid.*.*.value and grid.2.5.value.
+func SliceOf[T any](fn func(SliceValidator[T]) error) Validator[[]T] {
+	return func(value []T) error {
+		return fn(SliceValidator[T]{value: value, nested: true})
+	}
+}
+
+// Concurrent returns a SliceValidator that runs Items and Each with at most workers goroutines.
+// The errors are still returned in index order. Validation stops as soon as a validator returns an
+// exception or the context is done. The validators must be safe for concurrent use.
+func (v SliceValidator[T]) Concurrent(ctx context.Context, workers int) SliceValidator[T] {
+	v.ctx = ctx
+	v.workers = workers
+	return v
+}
+
+// Warnings returns a SliceValidator that moves the non blocking violations of Items and Each to warnings.
+func (v SliceValidator[T]) Warnings(warnings *Errors) SliceValidator[T] {
+	v.warnings = warnings
+	return v
+}
+
+// Items runs the validators on each element and reports the errors at <name>.<index>.<field>.
+// Errors returned by the validators, for example by ResolveField, are prefixed with the same path.
+// The error is nil if there are only non blocking violations, use Warnings to retrieve them.
 func (v SliceValidator[T]) Items(field string, validators ...Validator[T]) error {
+	if v.workers > 0 {
+		return v.blocking(validateConcurrent(v.ctx, v.name, field, v.value, v.workers, validators...))
+	}
+
+	return v.blocking(validateIndexed(v.name, field, slices.All(v.value), 0, validators...))
+}
+
+// blocking removes the non blocking violations from err. A nested SliceValidator keeps them so they
+// are prefixed by the outer Slice or Map.
+func (v SliceValidator[T]) blocking(err error) error {
+	if v.nested {
+		return err
+	}
+
+	return blocking(err, v.warnings)
+}
+
+// Each runs the validators on each element and reports the errors directly at <name>.<index>.
+// Use ResolveField to validate multiple fields of a struct element in one call.
+func (v SliceValidator[T]) Each(validators ...Validator[T]) error {
+	return v.Items("", validators...)
+}
+
+func prefixSliceError(err Error, name string, field string, index int) Error {
+	err.Path = joinPath(name, "*", field, err.Path)
+	err.ExactPath = joinPath(name, strconv.Itoa(index), field, err.ExactPath)
+	err.Args = err.Args.Add("index", index)
+	return err
+}
+
+// Len validates that the slice contains exactly length elements.
+func (v SliceValidator[T]) Len(length int) error {
+	if len(v.value) != length {
+		return v.error(Violation{Code: CodeLen, Args: Args{"len": length}})
+	}
+
+	return nil
+}
+
+// MinLen validates that the slice contains at least min elements.
+func (v SliceValidator[T]) MinLen(min int) error {
+	if len(v.value) < min {
+		return v.error(Violation{Code: CodeLenMin, Args: Args{"min": min}})
+	}
+
+	return nil
+}
+
+// MaxLen validates that the slice contains at most max elements.
+func (v SliceValidator[T]) MaxLen(max int) error {
+	if len(v.value) > max {
+		return v.error(Violation{Code: CodeLenMax, Args: Args{"max": max}})
+	}
+
+	return nil
+}
+
+// Contains validates that at least one element matches.
+func (v SliceValidator[T]) Contains(match func(T) bool) error {
+	if !slices.ContainsFunc(v.value, match) {
+		return v.error(Violation{Code: CodeContains})
+	}
+
+	return nil
+}
+
+// Unique validates that all elements of the slice are unique.
+// See UniqueBy for the reported errors.
+func Unique[T comparable](v SliceValidator[T]) error {
+	return UniqueBy(v, func(value T) T { return value })
+}
+
+// UniqueBy validates that the key of every element of the slice is unique.
+// Every duplicate is reported at its index with the index of the first occurrence in the first arg.
+func UniqueBy[T any, K comparable](v SliceValidator[T], key func(T) K) error {
 	var verrs Errors
 
-	path := fmt.Sprintf("%s.*", v.name)
+	seen := make(map[K]int, len(v.value))
 	for i, value := range v.value {
-		violations, err := validate(value, validators...)
-		if err != nil {
-			// It could be that the validators returned an Error or Errors. If so we map it with the correct paths.
-			if isValidationError(err) {
-				switch err := err.(type) {
-				case Error:
-					verrs = verrs.merge(prefixSliceError(err, v.name, field, i))
-				case Errors:
-					verrs = verrs.mergeAll(err.mapErrors(func(err Error) Error {
-						return prefixSliceError(err, v.name, field, i)
-					}))
-				}
-			} else {
-				return err
-			}
+		k := key(value)
+
+		first, ok := seen[k]
+		if !ok {
+			seen[k] = i
+			continue
 		}
 
-		if len(violations) > 0 {
-			verrs = append(verrs, Error{
-				Path:       path + "." + field,
-				ExactPath:  fmt.Sprintf("%s.%d.%s", v.name, i, field),
-				Violations: violations,
-				Args:       Args{"index": i},
-			})
+		verrs = append(verrs, v.indexError(i, Violation{Code: CodeUnique, Args: Args{"first": first}}))
+	}
+
+	if len(verrs) == 0 {
+		return nil
+	}
+
+	return verrs
+}
+
+// SortedBy validates that the elements are sorted according to the compare function.
+// Every element that is smaller than its predecessor is reported at its index.
+func (v SliceValidator[T]) SortedBy(compare func(a, b T) int) error {
+	var verrs Errors
+
+	for i := 1; i < len(v.value); i++ {
+		if compare(v.value[i-1], v.value[i]) > 0 {
+			verrs = append(verrs, v.indexError(i, Violation{Code: CodeSorted}))
 		}
 	}
 
@@ -54,9 +166,21 @@ func (v SliceValidator[T]) Items(field string, validators ...Validator[T]) error
 	return verrs
 }
 
-func prefixSliceError(err Error, name string, field string, index int) Error {
-	err.Path = prefixPath(err.Path, fmt.Sprintf("%s.*.%s", name, field))
-	err.ExactPath = prefixPath(err.ExactPath, fmt.Sprintf("%s.%d.%s", name, index, field))
-	err.Args = err.Args.Add("index", index)
-	return err
+// error returns an error for the slice itself.
+func (v SliceValidator[T]) error(violation Violation) Error {
+	return Error{
+		Path:       v.name,
+		ExactPath:  v.name,
+		Violations: []Violation{violation},
+	}
+}
+
+// indexError returns an error for the element at the index.
+func (v SliceValidator[T]) indexError(index int, violation Violation) Error {
+	return Error{
+		Path:       joinPath(v.name, "*"),
+		ExactPath:  joinPath(v.name, strconv.Itoa(index)),
+		Violations: []Violation{violation},
+		Args:       Args{"index": index},
+	}
 }
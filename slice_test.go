@@ -1,6 +1,9 @@
 package validate_test
 
 import (
+	"cmp"
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/SLASH2NL/validate"
@@ -59,3 +62,251 @@ func TestSliceResolve(t *testing.T) {
 	require.Equal(t, "data.1.amount", errs[0].ExactPath)
 	require.Equal(t, "max", errs[0].Violations[0].Code)
 }
+
+func TestSliceLen(t *testing.T) {
+	data := []string{"a", "b", "c"}
+
+	require.NoError(t, validate.Slice("items", data).Len(3))
+	require.NoError(t, validate.Slice("items", data).MinLen(1))
+	require.NoError(t, validate.Slice("items", data).MaxLen(3))
+
+	errs := validate.Collect(validate.Slice("items", data).Len(2))
+	require.Equal(t, 1, len(errs))
+	require.Equal(t, "items", errs[0].ExactPath)
+	require.Equal(t, validate.CodeLen, errs[0].Violations[0].Code)
+
+	errs = validate.Collect(validate.Slice("items", []string{}).MinLen(1))
+	require.Equal(t, 1, len(errs))
+	require.Equal(t, validate.CodeLenMin, errs[0].Violations[0].Code)
+
+	errs = validate.Collect(validate.Slice("items", data).MaxLen(2))
+	require.Equal(t, 1, len(errs))
+	require.Equal(t, validate.CodeLenMax, errs[0].Violations[0].Code)
+}
+
+func TestSliceUnique(t *testing.T) {
+	t.Run("unique", func(t *testing.T) {
+		require.NoError(t, validate.Unique(validate.Slice("skus", []string{"a", "b"})))
+
+		errs := validate.Collect(validate.Unique(validate.Slice("skus", []string{"a", "b", "a", "b", "a"})))
+		require.Equal(t, 3, len(errs))
+		require.Equal(t, "skus.*", errs[0].Path)
+		require.Equal(t, "skus.2", errs[0].ExactPath)
+		require.Equal(t, validate.CodeUnique, errs[0].Violations[0].Code)
+		require.Equal(t, 0, errs[0].Violations[0].Args["first"])
+		require.Equal(t, "skus.3", errs[1].ExactPath)
+		require.Equal(t, 1, errs[1].Violations[0].Args["first"])
+		require.Equal(t, "skus.4", errs[2].ExactPath)
+		require.Equal(t, 0, errs[2].Violations[0].Args["first"])
+	})
+
+	t.Run("unique by", func(t *testing.T) {
+		data := []testSlice{
+			{Name: "John Deer", Amount: 9},
+			{Name: "Deer John", Amount: 1},
+			{Name: "John Deer", Amount: 2},
+		}
+
+		errs := validate.Collect(validate.UniqueBy(validate.Slice("data", data), func(v testSlice) string { return v.Name }))
+		require.Equal(t, 1, len(errs))
+		require.Equal(t, "data.2", errs[0].ExactPath)
+		require.Equal(t, 2, errs[0].Args["index"])
+	})
+}
+
+func TestSliceContains(t *testing.T) {
+	data := []testSlice{
+		{Name: "John Deer", Amount: 9},
+	}
+
+	require.NoError(t, validate.Slice("data", data).Contains(func(v testSlice) bool { return v.Amount == 9 }))
+
+	errs := validate.Collect(validate.Slice("data", data).Contains(func(v testSlice) bool { return v.Amount == 1 }))
+	require.Equal(t, 1, len(errs))
+	require.Equal(t, "data", errs[0].ExactPath)
+	require.Equal(t, validate.CodeContains, errs[0].Violations[0].Code)
+}
+
+func TestSliceSortedBy(t *testing.T) {
+	require.NoError(t, validate.Slice("dates", []int{1, 2, 2, 5}).SortedBy(cmp.Compare[int]))
+
+	errs := validate.Collect(validate.Slice("dates", []int{1, 3, 2, 5, 4}).SortedBy(cmp.Compare[int]))
+	require.Equal(t, 2, len(errs))
+	require.Equal(t, "dates.2", errs[0].ExactPath)
+	require.Equal(t, validate.CodeSorted, errs[0].Violations[0].Code)
+	require.Equal(t, "dates.4", errs[1].ExactPath)
+}
+
+func TestSliceNested(t *testing.T) {
+	type cell struct {
+		Value int
+	}
+
+	type group struct {
+		Items []testSlice
+	}
+
+	t.Run("slice of slices", func(t *testing.T) {
+		grid := [][]cell{
+			{{Value: 1}, {Value: 2}},
+			{{Value: 3}, {Value: -1}},
+		}
+
+		err := validate.Slice("grid", grid).Items("", validate.SliceOf(func(row validate.SliceValidator[cell]) error {
+			return validate.Join(
+				row.MaxLen(1),
+				row.Items("value", validate.Resolve(func(c cell) int { return c.Value }, validate.MinNumber(0))...),
+			)
+		}))
+		errs := validate.Collect(err)
+		require.Equal(t, 3, len(errs))
+		require.Equal(t, "grid.*", errs[0].Path)
+		require.Equal(t, "grid.0", errs[0].ExactPath)
+		require.Equal(t, validate.CodeLenMax, errs[0].Violations[0].Code)
+		require.Equal(t, "grid.1", errs[1].ExactPath)
+		require.Equal(t, "grid.*.*.value", errs[2].Path)
+		require.Equal(t, "grid.1.1.value", errs[2].ExactPath)
+		require.Equal(t, 1, errs[2].Args["index"])
+	})
+
+	t.Run("slice of structs with slices", func(t *testing.T) {
+		groups := []group{
+			{Items: []testSlice{{Name: "a"}}},
+			{Items: []testSlice{{Name: "b"}, {Name: ""}}},
+		}
+
+		err := validate.Slice("groups", groups).Items("items", validate.Resolve(
+			func(g group) []testSlice { return g.Items },
+			validate.SliceOf(func(items validate.SliceValidator[testSlice]) error {
+				return items.Items("sku", validate.Resolve(func(i testSlice) string { return i.Name }, validate.Required)...)
+			}),
+		)...)
+		errs := validate.Collect(err)
+		require.Equal(t, 1, len(errs))
+		require.Equal(t, "groups.*.items.*.sku", errs[0].Path)
+		require.Equal(t, "groups.1.items.1.sku", errs[0].ExactPath)
+	})
+
+	t.Run("map of slices", func(t *testing.T) {
+		groups := map[string][]string{
+			"first":  {"a"},
+			"second": {"b", ""},
+		}
+
+		err := validate.Map("groups", groups).Values("items", validate.SliceOf(func(items validate.SliceValidator[string]) error {
+			return items.Items("sku", validate.Required)
+		}))
+		errs := validate.Collect(err)
+		require.Equal(t, 1, len(errs))
+		require.Equal(t, "groups.*.items.*.sku", errs[0].Path)
+		require.Equal(t, "groups.second.items.1.sku", errs[0].ExactPath)
+		require.Equal(t, "second", errs[0].Args["key"])
+	})
+
+	t.Run("slice of maps", func(t *testing.T) {
+		rows := []map[string]int{
+			{"a": 1},
+			{"a": 1, "b": -1},
+		}
+
+		err := validate.Slice("rows", rows).Items("", validate.MapOf(func(row validate.MapValidator[string, int]) error {
+			return row.Values("value", validate.MinNumber(0))
+		}))
+		errs := validate.Collect(err)
+		require.Equal(t, 1, len(errs))
+		require.Equal(t, "rows.*.*.value", errs[0].Path)
+		require.Equal(t, "rows.1.b.value", errs[0].ExactPath)
+	})
+}
+
+func TestSliceEach(t *testing.T) {
+	t.Run("elements", func(t *testing.T) {
+		err := validate.Slice("emails", []string{"test@example.org", "invalid"}).Each(validate.Email)
+		errs := validate.Collect(err)
+		require.Equal(t, 1, len(errs))
+		require.Equal(t, "emails.*", errs[0].Path)
+		require.Equal(t, "emails.1", errs[0].ExactPath)
+		require.Equal(t, validate.CodeEmail, errs[0].Violations[0].Code)
+		require.Equal(t, 1, errs[0].Args["index"])
+	})
+
+	t.Run("resolve field", func(t *testing.T) {
+		data := []testSlice{
+			{Name: "John Deer", Amount: 9},
+			{Name: "", Amount: 1},
+		}
+
+		err := validate.Slice("data", data).Each(
+			validate.ResolveField("name", func(v testSlice) string { return v.Name }, validate.Required),
+			validate.ResolveField("amount", func(v testSlice) int { return v.Amount }, validate.MinNumber(5)),
+		)
+		errs := validate.Collect(err)
+		require.Equal(t, 2, len(errs))
+		require.Equal(t, "data.*.name", errs[0].Path)
+		require.Equal(t, "data.1.name", errs[0].ExactPath)
+		require.Equal(t, validate.CodeRequired, errs[0].Violations[0].Code)
+		require.Equal(t, "data.*.amount", errs[1].Path)
+		require.Equal(t, "data.1.amount", errs[1].ExactPath)
+		require.Equal(t, validate.CodeNumberMin, errs[1].Violations[0].Code)
+	})
+
+	t.Run("resolve field in items", func(t *testing.T) {
+		data := []testSlice{
+			{Name: "", Amount: 1},
+		}
+
+		err := validate.Slice("data", data).Items("line",
+			validate.ResolveField("name", func(v testSlice) string { return v.Name }, validate.Required),
+			validate.ResolveField("amount", func(v testSlice) int { return v.Amount }, validate.MinNumber(5)),
+		)
+		errs := validate.Collect(err)
+		require.Equal(t, 2, len(errs))
+		require.Equal(t, "data.0.line.name", errs[0].ExactPath)
+		require.Equal(t, "data.0.line.amount", errs[1].ExactPath)
+	})
+
+	t.Run("exception keeps earlier violations", func(t *testing.T) {
+		exception := errors.New("some exception")
+		lines := []string{"", "ok", "fail", ""}
+		validator := func(value string) error {
+			if value == "fail" {
+				return exception
+			}
+
+			return validate.Required(value)
+		}
+
+		sequential := validate.Slice("lines", lines).Each(validator)
+		concurrent := validate.Slice("lines", lines).Concurrent(context.Background(), 2).Each(validator)
+		require.Equal(t, sequential, concurrent)
+
+		for _, err := range []error{sequential, concurrent} {
+			require.ErrorIs(t, err, exception)
+
+			var exceptionErr validate.ExceptionError
+			require.ErrorAs(t, err, &exceptionErr)
+			require.Equal(t, "lines.2", exceptionErr.ExactPath)
+
+			errs := validate.Collect(err)
+			require.Equal(t, 1, len(errs))
+			require.Equal(t, "lines.0", errs[0].ExactPath)
+		}
+	})
+}
+
+func TestSliceEachResolveFieldOnce(t *testing.T) {
+	calls := 0
+	name := func(v testSlice) string {
+		calls++
+		return v.Name
+	}
+
+	data := []testSlice{{Name: ""}, {Name: "John Deer"}}
+	err := validate.Slice("data", data).Each(validate.ResolveField("name", name, validate.Required, validate.MinString(3)))
+	require.Equal(t, 2, calls)
+
+	errs := validate.Collect(err)
+	require.Equal(t, 1, len(errs))
+	require.Equal(t, "data.0.name", errs[0].ExactPath)
+	require.Equal(t, 2, len(errs[0].Violations))
+}
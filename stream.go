@@ -0,0 +1,108 @@
+package validate
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// RecordHandler is called for every decoded record by NDJSON and JSONArray.
+// The err is nil if the record is valid, otherwise it contains the validation errors of the record.
+// Returning an error stops the decoding and that error is returned to the caller.
+type RecordHandler[T any] func(index int, record T, err error) error
+
+// NDJSON decodes newline delimited JSON records one at a time from r and runs the validator on every record.
+// The errors of a record are prefixed with <name>.<index> where index is the zero based line number.
+// Records that can not be decoded are reported with a format violation and decoding continues with the next line.
+// Empty lines are skipped. Only exceptions from reading, the validator or the handler are returned.
+func NDJSON[T any](name string, r io.Reader, validator Validator[T], handle RecordHandler[T]) error {
+	reader := bufio.NewReader(r)
+
+	for index := 0; ; index++ {
+		line, err := reader.ReadBytes('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+
+		if len(bytes.TrimSpace(line)) > 0 {
+			var record T
+			if uerr := json.Unmarshal(line, &record); uerr != nil {
+				if herr := handle(index, record, recordFormatError(name, index)); herr != nil {
+					return herr
+				}
+			} else if herr := validateRecord(name, index, record, validator, handle); herr != nil {
+				return herr
+			}
+		}
+
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+	}
+}
+
+// JSONArray decodes the elements of a JSON array one at a time from r and runs the validator on every element.
+// The errors of an element are prefixed with <name>.<index>.
+// Elements that do not match the type of T are reported with a format violation, malformed JSON is returned as exception.
+func JSONArray[T any](name string, r io.Reader, validator Validator[T], handle RecordHandler[T]) error {
+	dec := json.NewDecoder(r)
+
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	if token != json.Delim('[') {
+		return fmt.Errorf("expected JSON array, got %v", token)
+	}
+
+	for index := 0; dec.More(); index++ {
+		var record T
+		if err := dec.Decode(&record); err != nil {
+			var typeErr *json.UnmarshalTypeError
+			if !errors.As(err, &typeErr) {
+				return err
+			}
+
+			if herr := handle(index, record, recordFormatError(name, index)); herr != nil {
+				return herr
+			}
+
+			continue
+		}
+
+		if err := validateRecord(name, index, record, validator, handle); err != nil {
+			return err
+		}
+	}
+
+	_, err = dec.Token()
+	return err
+}
+
+// validateRecord runs the validator on the record and passes the result to the handler.
+func validateRecord[T any](name string, index int, record T, validator Validator[T], handle RecordHandler[T]) error {
+	single := func(yield func(int, T) bool) {
+		yield(index, record)
+	}
+
+	err := validateIndexed(name, "", single, 0, validator)
+	if err != nil && !IsValidationError(err) {
+		return err
+	}
+
+	return handle(index, record, err)
+}
+
+func recordFormatError(name string, index int) Error {
+	return Error{
+		Path:       joinPath(name, "*"),
+		ExactPath:  joinPath(name, strconv.Itoa(index)),
+		Violations: []Violation{{Code: CodeFormat, Args: Args{"type": "json"}}},
+		Args:       Args{"index": index},
+	}
+}
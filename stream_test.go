@@ -0,0 +1,77 @@
+package validate_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/SLASH2NL/validate"
+	"github.com/stretchr/testify/require"
+)
+
+type testRecord struct {
+	Email string `json:"email"`
+}
+
+func validateRecord(r testRecord) error {
+	return validate.Field("email", r.Email, validate.Email)
+}
+
+func TestNDJSON(t *testing.T) {
+	input := `{"email": "test@example.org"}
+{"email": "invalid"}
+
+{"email": 
+{"email": "other@example.org"}`
+
+	var valid int
+	var errs []validate.Error
+	err := validate.NDJSON("records", strings.NewReader(input), validateRecord, func(index int, record testRecord, err error) error {
+		if err == nil {
+			valid++
+			return nil
+		}
+
+		errs = append(errs, validate.Collect(err)...)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, valid)
+	require.Equal(t, 2, len(errs))
+	require.Equal(t, "records.*.email", errs[0].Path)
+	require.Equal(t, "records.1.email", errs[0].ExactPath)
+	require.Equal(t, validate.CodeEmail, errs[0].Violations[0].Code)
+	require.Equal(t, "records.3", errs[1].ExactPath)
+	require.Equal(t, validate.CodeFormat, errs[1].Violations[0].Code)
+}
+
+func TestNDJSONStop(t *testing.T) {
+	stop := errors.New("stop")
+
+	calls := 0
+	err := validate.NDJSON("records", strings.NewReader("{}\n{}\n{}\n"), validateRecord, func(index int, record testRecord, err error) error {
+		calls++
+		return stop
+	})
+	require.Equal(t, stop, err)
+	require.Equal(t, 1, calls)
+}
+
+func TestJSONArray(t *testing.T) {
+	input := `[{"email": "test@example.org"}, {"email": 1}, {"email": "invalid"}]`
+
+	var errs []validate.Error
+	err := validate.JSONArray("records", strings.NewReader(input), validateRecord, func(index int, record testRecord, err error) error {
+		errs = append(errs, validate.Collect(err)...)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(errs))
+	require.Equal(t, "records.1", errs[0].ExactPath)
+	require.Equal(t, validate.CodeFormat, errs[0].Violations[0].Code)
+	require.Equal(t, "records.2.email", errs[1].ExactPath)
+
+	err = validate.JSONArray("records", strings.NewReader(`{"email": "test"}`), validateRecord, func(int, testRecord, error) error { return nil })
+	require.Error(t, err)
+	require.False(t, validate.IsValidationError(err))
+}
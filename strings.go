@@ -1,7 +1,9 @@
 package validate
 
 import (
+	"fmt"
 	"net/mail"
+	"net/url"
 	"regexp"
 	"strings"
 	"unicode"
@@ -10,7 +12,7 @@ import (
 func Email(value string) error {
 	_, merr := mail.ParseAddress(string(value))
 	if merr != nil {
-		return &Violation{Code: CodeEmail}
+		return &Violation{Code: CodeEmail, Message: "must be a valid email address", Cause: merr}
 	}
 
 	return nil
@@ -19,7 +21,7 @@ func Email(value string) error {
 func Regex(re *regexp.Regexp) Validator[string] {
 	return func(value string) error {
 		if !re.MatchString(value) {
-			return &Violation{Code: CodeRegex, Args: Args{"pattern": re.String()}}
+			return &Violation{Code: CodeRegex, Args: Args{"pattern": re.String()}, Message: "must match the pattern " + re.String()}
 		}
 
 		return nil
@@ -29,7 +31,7 @@ func Regex(re *regexp.Regexp) Validator[string] {
 func MinString(length int) Validator[string] {
 	return func(value string) error {
 		if len(value) < length {
-			return &Violation{Code: CodeStringMin, Args: Args{"min": length}}
+			return &Violation{Code: CodeStringMin, Args: Args{"min": length}, Message: fmt.Sprintf("must be at least %d characters", length)}
 		}
 
 		return nil
@@ -39,7 +41,7 @@ func MinString(length int) Validator[string] {
 func MaxString(length int) Validator[string] {
 	return func(value string) error {
 		if len(value) > length {
-			return &Violation{Code: CodeStringMax, Args: Args{"max": length}}
+			return &Violation{Code: CodeStringMax, Args: Args{"max": length}, Message: fmt.Sprintf("must be at most %d characters", length)}
 		}
 
 		return nil
@@ -49,7 +51,7 @@ func MaxString(length int) Validator[string] {
 func Lowercase(value string) error {
 	for _, r := range value {
 		if unicode.IsUpper(r) {
-			return &Violation{Code: CodeLowercase}
+			return &Violation{Code: CodeLowercase, Message: "must be lowercase"}
 		}
 	}
 
@@ -59,7 +61,7 @@ func Lowercase(value string) error {
 func Uppercase(value string) error {
 	for _, r := range value {
 		if unicode.IsLower(r) {
-			return &Violation{Code: CodeUppercase}
+			return &Violation{Code: CodeUppercase, Message: "must be uppercase"}
 		}
 	}
 
@@ -69,7 +71,7 @@ func Uppercase(value string) error {
 func Prefix(prefix string) Validator[string] {
 	return func(value string) error {
 		if !strings.HasPrefix(value, prefix) {
-			return &Violation{Code: "prefix", Args: Args{"prefix": prefix}}
+			return &Violation{Code: CodePrefix, Args: Args{"prefix": prefix}, Message: "must start with " + prefix}
 		}
 
 		return nil
@@ -79,9 +81,19 @@ func Prefix(prefix string) Validator[string] {
 func Suffix(suffix string) Validator[string] {
 	return func(value string) error {
 		if !strings.HasSuffix(value, suffix) {
-			return &Violation{Code: "suffix", Args: Args{"suffix": suffix}}
+			return &Violation{Code: CodeSuffix, Args: Args{"suffix": suffix}, Message: "must end with " + suffix}
 		}
 
 		return nil
 	}
 }
+
+// URL validates that the value is an absolute URL with a scheme and host.
+func URL(value string) error {
+	u, err := url.Parse(value)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return &Violation{Code: CodeURL, Message: "must be an absolute URL", Cause: err}
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package validate_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/SLASH2NL/validate"
@@ -56,3 +57,21 @@ func TestStrLowercase(t *testing.T) {
 	err = validate.Lowercase("test")
 	require.Nil(t, err)
 }
+
+func TestURL(t *testing.T) {
+	err := validate.URL("example.org")
+	require.NotNil(t, err)
+
+	err = validate.URL("https://example.org/path")
+	require.Nil(t, err)
+}
+
+func TestEmailCause(t *testing.T) {
+	err := validate.Email("invalid")
+
+	var violation *validate.Violation
+	require.ErrorAs(t, err, &violation)
+	require.Equal(t, validate.CodeEmail, violation.Code)
+	require.Equal(t, "must be a valid email address", violation.Message)
+	require.EqualError(t, errors.Unwrap(violation), "mail: missing '@' or angle-addr")
+}
@@ -1,7 +1,5 @@
 package validate
 
-import "errors"
-
 // Validator represents a validator that can be used to validate a value.
 // If a validator fails it should return an new Violation.
 // If there is an unexpected exception a normal error should be returned. This error
@@ -10,9 +8,20 @@ type Validator[T any] func(value T) error
 
 // Field will run the validators on the value and return the errors grouped by the field.
 // If a violation returned a non Violation that is returned as exception error.
+// The error is nil if there are only non blocking violations, use FieldResult to retrieve them.
 func Field[T any](fieldName string, value T, validators ...Validator[T]) error {
+	return blocking(field(fieldName, value, validators...), nil)
+}
+
+// field runs the validators like Field but keeps the non blocking violations.
+func field[T any](fieldName string, value T, validators ...Validator[T]) error {
 	violations, err := validate(value, validators...)
 	if err != nil {
+		// A recovered panic is annotated with the field so the exact path is known.
+		if _, ok := err.(PanicError); ok {
+			return wrapException(err, fieldName, fieldName, nil)
+		}
+
 		return err
 	}
 
@@ -29,7 +38,14 @@ func Field[T any](fieldName string, value T, validators ...Validator[T]) error {
 
 // Join the errors into a single slice and merge all errors with the same exact path.
 // It wil only Join errors that are of the type Error or Errors.
+// The error is nil if there are only non blocking violations, use FieldWarnings or the Warnings option of
+// Slice, Map and Seq to retrieve them.
 func Join(errs ...error) error {
+	return blocking(join(errs...), nil)
+}
+
+// join joins the errors like Join but keeps the non blocking violations.
+func join(errs ...error) error {
 	verrs := Errors{}
 
 	for _, e := range errs {
@@ -45,11 +61,58 @@ func Join(errs ...error) error {
 		case Error:
 			verrs = verrs.merge(e)
 		default:
-			// If we encountered an exception we just return that.
-			return e
+			// The error could be a tree of errors like the result of errors.Join.
+			// If it contains only validation errors we merge them, otherwise we return the exception.
+			errs, exceptions := splitErrors(e)
+			if len(exceptions) > 0 {
+				return e
+			}
+
+			verrs = verrs.mergeAll(errs)
+		}
+	}
+
+	if len(verrs) == 0 {
+		return nil
+	}
+
+	return verrs
+}
+
+// JoinAll joins the errors like Join but does not stop at an exception.
+// If there are no exceptions it returns the same result as Join. Otherwise it returns an AggregateError
+// that contains both the validation errors and the exceptions, so a failing lookup in one field does not
+// hide the violations of the other fields.
+func JoinAll(errs ...error) error {
+	return blocking(joinAll(errs...), nil)
+}
+
+// joinAll joins the errors like JoinAll but keeps the non blocking violations.
+func joinAll(errs ...error) error {
+	var verrs Errors
+	var exceptions []error
+
+	for _, e := range errs {
+		if e == nil {
+			continue
+		}
+
+		switch e := e.(type) {
+		case Errors:
+			verrs = verrs.mergeAll(e)
+		case Error:
+			verrs = verrs.merge(e)
+		default:
+			errs, errExceptions := splitErrors(e)
+			verrs = verrs.mergeAll(errs)
+			exceptions = append(exceptions, errExceptions...)
 		}
 	}
 
+	if len(exceptions) > 0 {
+		return AggregateError{Errors: verrs, Exceptions: exceptions}
+	}
+
 	if len(verrs) == 0 {
 		return nil
 	}
@@ -57,7 +120,19 @@ func Join(errs ...error) error {
 	return verrs
 }
 
+// FieldAll runs the validators on the value like Field but does not stop at an exception.
+// If a validator returns an exception the violations of the other validators are kept in an AggregateError.
+func FieldAll[T any](fieldName string, value T, validators ...Validator[T]) error {
+	var errs []error
+	for _, validator := range validators {
+		errs = append(errs, field(fieldName, value, validator))
+	}
+
+	return JoinAll(errs...)
+}
+
 // Collect will collect the Errors from the given error.
+// Wrapped errors and error trees like the result of errors.Join are traversed and every Error is collected.
 func Collect(err error) []Error {
 	switch e := err.(type) {
 	case Errors:
@@ -65,20 +140,13 @@ func Collect(err error) []Error {
 	case Error:
 		return []Error{e}
 	default:
-		// The error could be wrapped, try to unwrap it.
-		var errs Errors
-		if errors.As(err, &errs) {
-			return errs
-		}
-
-		var single Error
-		if errors.As(err, &single) {
-			return []Error{single}
+		errs, _ := splitErrors(err)
+		if errs == nil {
+			return []Error{}
 		}
 
-		return []Error{}
+		return errs
 	}
-
 }
 
 // And will run all validators and only return an error if all validators error.
@@ -170,22 +238,23 @@ func ReplaceIfErr(err error, newErr error) error {
 	return nil
 }
 
-// Group will prefix the exact path in the given error.
+// Group will prefix the path and exact path in the given error.
 // This function accepts Error and Errors.
 func Group(prefix string, err error) error {
-	switch err := err.(type) {
-	case Error:
-		err.ExactPath = prefix + "." + err.ExactPath
-		return err
-	case Errors:
-		for j, e := range err {
-			e.ExactPath = prefix + "." + e.ExactPath
-			err[j] = e
-		}
-		return err
-	}
+	return mapError(err, func(e Error) Error {
+		e.Path = joinPath(prefix, e.Path)
+		e.ExactPath = joinPath(prefix, e.ExactPath)
+		return e
+	})
+}
 
-	return err
+// ResolveField will resolve the value once and run the validators on the resolved value.
+// The violations are reported at the given field so a single Items or Each call can validate
+// multiple fields of an element with the correct path for every field.
+func ResolveField[Original any, Resolved any](fieldName string, resolveFunc func(Original) Resolved, validators ...Validator[Resolved]) Validator[Original] {
+	return func(input Original) error {
+		return field(fieldName, resolveFunc(input), validators...)
+	}
 }
 
 // validate will run the validators on the value and return the violations.
@@ -218,3 +287,41 @@ func validate[T any](
 
 	return violations, nil
 }
+
+// validateNested will run the validators on the value like validate but also collects the Error and Errors
+// returned by validators so the caller can prefix them with the path of the value.
+// Error trees like an AggregateError are split, it stops at the first validator that returned an exception
+// and returns the exceptions next to the errors collected so far.
+func validateNested[T any](
+	value T,
+	validators ...Validator[T],
+) ([]Violation, Errors, []error) {
+	var violations []Violation
+	var verrs Errors
+
+	for _, validator := range validators {
+		err := validator(value)
+		if err == nil {
+			continue
+		}
+
+		switch err := err.(type) {
+		case Violations:
+			violations = append(violations, err...)
+		case *Violation:
+			violations = append(violations, *err)
+		case Error:
+			verrs = verrs.merge(err)
+		case Errors:
+			verrs = verrs.mergeAll(err)
+		default:
+			errs, exceptions := splitErrors(err)
+			verrs = verrs.mergeAll(errs)
+			if len(exceptions) > 0 {
+				return violations, verrs, exceptions
+			}
+		}
+	}
+
+	return violations, verrs, nil
+}
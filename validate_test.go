@@ -173,6 +173,58 @@ func TestJoinMergesErrors(t *testing.T) {
 	require.Equal(t, "iban.fail", errs[1].Violations[0].Code)
 }
 
+func TestJoinAll(t *testing.T) {
+	exception := errors.New("some exception")
+
+	t.Run("keeps violations", func(t *testing.T) {
+		err := validate.JoinAll(
+			validate.Field("name", "", failValidatorWithCode[string]("name.fail")),
+			validate.Field("customer_id", "1", func(value string) error { return exception }),
+			validate.Field("iban", "invalid", failValidatorWithCode[string]("iban.fail")),
+		)
+		require.ErrorIs(t, err, exception)
+		require.False(t, validate.IsValidationError(err))
+		require.Equal(t, []error{exception}, validate.Exceptions(err))
+
+		var aggregate validate.AggregateError
+		require.ErrorAs(t, err, &aggregate)
+		require.Equal(t, []error{exception}, aggregate.Exceptions)
+
+		errs := validate.Collect(err)
+		require.Equal(t, 2, len(errs))
+		require.Equal(t, "name", errs[0].Path)
+		require.Equal(t, "iban", errs[1].Path)
+	})
+
+	t.Run("without exceptions", func(t *testing.T) {
+		err := validate.JoinAll(
+			validate.Field("name", "", failValidatorWithCode[string]("name.fail")),
+			nil,
+		)
+		_, ok := err.(validate.Errors)
+		require.True(t, ok)
+
+		require.NoError(t, validate.JoinAll(nil, nil))
+	})
+
+	t.Run("field all", func(t *testing.T) {
+		err := validate.FieldAll(
+			"name",
+			"",
+			failValidatorWithCode[string]("first"),
+			func(value string) error { return exception },
+			failValidatorWithCode[string]("second"),
+		)
+		require.ErrorIs(t, err, exception)
+
+		errs := validate.Collect(err)
+		require.Equal(t, 1, len(errs))
+		require.Equal(t, 2, len(errs[0].Violations))
+		require.Equal(t, "first", errs[0].Violations[0].Code)
+		require.Equal(t, "second", errs[0].Violations[1].Code)
+	})
+}
+
 func TestCollect(t *testing.T) {
 	t.Run("simple", func(t *testing.T) {
 		err := validate.Join(
@@ -239,7 +291,7 @@ func TestGroup(t *testing.T) {
 
 	errs := validate.Collect(err)
 	require.Equal(t, 1, len(errs))
-	require.Equal(t, "name", errs[0].Path)
+	require.Equal(t, "prefix.name", errs[0].Path)
 	require.Equal(t, "prefix.name", errs[0].ExactPath)
 }
 